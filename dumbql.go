@@ -11,10 +11,11 @@ type Query struct {
 }
 
 // Parse parses the input query string q, returning a Query reference or an error in case of invalid input.
+// Syntax errors are returned as query.ParseErrors.
 func Parse(q string, opts ...query.Option) (*Query, error) {
 	res, err := query.Parse("query", []byte(q), opts...)
 	if err != nil {
-		return nil, err
+		return nil, query.NewParseErrors([]byte(q), err)
 	}
 
 	return &Query{res.(query.Expr)}, nil
@@ -22,10 +23,16 @@ func Parse(q string, opts ...query.Option) (*Query, error) {
 
 // Validate checks the query against the provided schema, returning a validated expression or an error
 // if any rule is violated. Even when error returned Validate can return query AST with invalided nodes dropped.
-func (q *Query) Validate(s schema.Schema) (query.Expr, error) {
+func (q *Query) Validate(s schema.Definition) (query.Expr, error) {
 	return q.Expr.Validate(s)
 }
 
+// ValidateStrict checks the query against the provided schema like Validate, but returns nil expression if any rule
+// is violated, instead of dropping invalid nodes.
+func (q *Query) ValidateStrict(s schema.Definition) (query.Expr, error) {
+	return query.ValidateStrict(q.Expr, s)
+}
+
 // ToSql converts the Query into an SQL string, returning the SQL string, arguments slice,
 // and any potential error encountered.
 func (q *Query) ToSql() (string, []any, error) { //nolint:revive
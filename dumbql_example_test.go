@@ -1,10 +1,12 @@
 package dumbql_test
 
 import (
+	"errors"
 	"fmt"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/defer-panic/dumbql"
+	"github.com/defer-panic/dumbql/query"
 	"github.com/defer-panic/dumbql/schema"
 )
 
@@ -47,6 +49,26 @@ func ExampleQuery_Validate() {
 	// field "period_months": value must be equal or less than 3, got 4; field "name" not found in schema
 }
 
+func ExampleQuery_ValidateStrict() {
+	schm := schema.Schema{
+		"owner_id": schema.Is[int64](),
+		"status":   schema.EqualsOneOf("pending", "approved", "rejected"),
+	}
+
+	// Strict validation rejects the whole query instead of dropping `status:archived`.
+	const q = `owner_id:42 and not status:archived`
+	expr, err := dumbql.Parse(q)
+	if err != nil {
+		panic(err)
+	}
+
+	validated, err := expr.ValidateStrict(schm)
+	fmt.Println(validated)
+	fmt.Println(err)
+	// Output: <nil>
+	// field "status": value must be one of [pending approved rejected], got archived
+}
+
 func ExampleQuery_ToSql() {
 	const q = `status:pending and period_months < 4 and (title:"hello world" or name:"John Doe")`
 	expr, err := dumbql.Parse(q)
@@ -67,3 +89,19 @@ func ExampleQuery_ToSql() {
 	// Output: SELECT * FROM users WHERE ((status = ? AND period_months < ?) AND (title = ? OR name = ?))
 	// [pending 4 hello world John Doe]
 }
+
+func ExampleParse_errors() {
+	const q = `status:pending and (period_months < 4`
+	_, err := dumbql.Parse(q)
+
+	var parseErr *query.ParseError
+	if !errors.As(err, &parseErr) {
+		panic(err)
+	}
+
+	fmt.Println(parseErr.Line, parseErr.Column)
+	fmt.Println(parseErr.Caret(q))
+	// Output: 1 38
+	// status:pending and (period_months < 4
+	//                                      ^
+}
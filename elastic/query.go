@@ -0,0 +1,352 @@
+// Package elastic converts query expressions into Elasticsearch and OpenSearch Query DSL.
+package elastic
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/defer-panic/dumbql/query"
+	"github.com/defer-panic/dumbql/schema"
+)
+
+// Query converts the expression into Query DSL clause, which can be serialized to JSON as the `query` of the search
+// request:
+//
+//   - `and`, `or` and `not` become `bool` query with `must`, `should` and `must_not` clauses
+//   - `=` becomes `term` query, one-of becomes `terms`, and `!=` negates them with `must_not`
+//   - comparisons and ranges become `range` query
+//   - `~` becomes `wildcard` query matching the value as a substring, and `~*` makes it case-insensitive
+//   - wildcards, LIKE patterns and regular expressions become `wildcard` and `regexp` queries
+//   - field existence (`field:*`) and null checks become `exists` query
+//
+// Fields marked as Text in the schema are analyzed, so strings are matched with `match_phrase` instead of `term`
+// and `wildcard` queries. The schema can be nil, then all fields are treated as keywords. Free text terms must be
+// expanded by validation first.
+func Query(expr query.Expr, schm schema.Definition) (map[string]any, error) {
+	return (&converter{schema: schm}).expr(expr)
+}
+
+type converter struct {
+	schema schema.Definition
+}
+
+func (c *converter) expr(expr query.Expr) (map[string]any, error) {
+	switch e := expr.(type) {
+	case *query.BinaryExpr:
+		return c.binary(e)
+	case *query.NotExpr:
+		inner, err := c.expr(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+
+		return mustNot(inner), nil
+	case *query.FieldExpr:
+		return c.field(e)
+	case *query.TermExpr:
+		if len(e.Fields) == 0 {
+			return nil, fmt.Errorf("term %q: no fields to search", e.Term)
+		}
+
+		should := make([]any, 0, len(e.Fields))
+		for _, f := range e.Fields {
+			clause, err := c.field(f)
+			if err != nil {
+				return nil, err
+			}
+			should = append(should, clause)
+		}
+
+		return boolQuery("should", should), nil
+	default:
+		return nil, fmt.Errorf("unsupported expression %T", expr)
+	}
+}
+
+func (c *converter) binary(b *query.BinaryExpr) (map[string]any, error) {
+	var occur string
+
+	switch b.Op {
+	case query.And:
+		occur = "must"
+	case query.Or:
+		occur = "should"
+	default:
+		return nil, fmt.Errorf("unknown operator %q", b.Op)
+	}
+
+	var clauses []any
+
+	for _, operand := range []query.Expr{b.Left, b.Right} {
+		clause, err := c.expr(operand)
+		if err != nil {
+			return nil, err
+		}
+
+		// Flatten `a and b and c` into a single bool query.
+		if nested, ok := operand.(*query.BinaryExpr); ok && nested.Op == b.Op {
+			clauses = append(clauses, clause["bool"].(map[string]any)[occur].([]any)...)
+			continue
+		}
+
+		clauses = append(clauses, clause)
+	}
+
+	return boolQuery(occur, clauses), nil
+}
+
+// isText reports whether the field is analyzed full text according to the schema.
+func (c *converter) isText(field string) bool {
+	if c.schema == nil {
+		return false
+	}
+
+	desc, ok := c.schema.Describe(schema.Field(field))
+
+	return ok && desc.Text
+}
+
+func (c *converter) field(f *query.FieldExpr) (map[string]any, error) { //nolint:cyclop
+	field := f.Field.String()
+
+	if f.Op == query.Exists {
+		return exists(field), nil
+	}
+
+	switch v := f.Value.(type) {
+	case *query.RangeExpr:
+		return rangeQuery(field, v, f.Op)
+	case *query.OneOfExpr:
+		return c.oneOf(f, v)
+	case *query.WildcardLiteral:
+		// Wildcards of the query have the same syntax, only the escape character must be escaped.
+		return patternQuery(wildcard(field, strings.ReplaceAll(v.Pattern, `\`, `\\`), f.Op == query.ILike), f.Op)
+	case *query.LikePatternLiteral:
+		return patternQuery(wildcard(field, likeWildcard(v.Pattern), f.Op == query.ILike), f.Op)
+	case *query.RegexLiteral:
+		return patternQuery(regexpQuery(field, v.Pattern, v.CaseInsensitive || f.Op == query.ILike), f.Op)
+	}
+
+	value := f.Value.Value()
+	if value == nil && f.Op != query.Equal && f.Op != query.NotEqual {
+		return nil, fmt.Errorf("operator %q is not supported for null", f.Op)
+	}
+
+	s, isString := f.Value.(*query.StringLiteral)
+	folded := isString && s.CaseInsensitive
+
+	switch f.Op {
+	case query.Equal:
+		return c.equal(field, value, folded), nil
+	case query.NotEqual:
+		return mustNot(c.equal(field, value, folded)), nil
+	case query.GreaterThan:
+		return single("range", field, map[string]any{"gt": value}), nil
+	case query.GreaterThanOrEqual:
+		return single("range", field, map[string]any{"gte": value}), nil
+	case query.LessThan:
+		return single("range", field, map[string]any{"lt": value}), nil
+	case query.LessThanOrEqual:
+		return single("range", field, map[string]any{"lte": value}), nil
+	case query.Like, query.ILike:
+		return c.contains(field, fmt.Sprint(value), f.Op == query.ILike), nil
+	default:
+		return nil, fmt.Errorf("unknown operator %q", f.Op)
+	}
+}
+
+// equal returns `term` query, `match_phrase` for strings in text fields, or negated `exists` for null. Folded term
+// ignores case of the value.
+func (c *converter) equal(field string, value any, folded bool) map[string]any {
+	if value == nil {
+		return mustNot(exists(field))
+	}
+
+	if s, ok := value.(string); ok && c.isText(field) {
+		return single("match_phrase", field, s)
+	}
+
+	if folded {
+		return single("term", field, map[string]any{"value": value, "case_insensitive": true})
+	}
+
+	return single("term", field, value)
+}
+
+// contains returns query matching the value as a substring: `match_phrase` in text fields, which is case-insensitive
+// for most analyzers, and `wildcard` in keyword fields.
+func (c *converter) contains(field, value string, caseInsensitive bool) map[string]any {
+	if c.isText(field) {
+		return single("match_phrase", field, value)
+	}
+
+	return wildcard(field, "*"+escapeWildcard(value)+"*", caseInsensitive)
+}
+
+// oneOf converts one-of into `terms` for `=` and `!=`, or into `should` of any of the values for `~` and `~*`.
+func (c *converter) oneOf(f *query.FieldExpr, oneOf *query.OneOfExpr) (map[string]any, error) {
+	field := f.Field.String()
+
+	values := make([]any, 0, len(oneOf.Values))
+	folded := make([]bool, 0, len(oneOf.Values))
+	anyFolded := false
+
+	for _, v := range oneOf.Values {
+		s, isString := v.(*query.StringLiteral)
+		isFolded := isString && s.CaseInsensitive
+
+		values = append(values, v.Value())
+		folded = append(folded, isFolded)
+		anyFolded = anyFolded || isFolded
+	}
+
+	var clause map[string]any
+
+	switch f.Op { //nolint:exhaustive
+	case query.Equal, query.NotEqual:
+		// `terms` query can't ignore case, so folded values are matched with `term` queries one by one.
+		if !c.isText(field) && !anyFolded {
+			clause = single("terms", field, values)
+			break
+		}
+
+		should := make([]any, 0, len(values))
+		for i, v := range values {
+			should = append(should, c.equal(field, v, folded[i]))
+		}
+		clause = boolQuery("should", should)
+	case query.Like, query.ILike:
+		should := make([]any, 0, len(values))
+		for _, v := range values {
+			should = append(should, c.contains(field, fmt.Sprint(v), f.Op == query.ILike))
+		}
+		clause = boolQuery("should", should)
+	default:
+		return nil, fmt.Errorf("operator %q is not supported for one-of", f.Op)
+	}
+
+	if f.Op == query.NotEqual {
+		return mustNot(clause), nil
+	}
+
+	return clause, nil
+}
+
+func rangeQuery(field string, r *query.RangeExpr, op query.FieldOperator) (map[string]any, error) {
+	if op != query.Equal && op != query.NotEqual {
+		return nil, fmt.Errorf("operator %q is not supported for range", op)
+	}
+
+	if r.Low == nil && r.High == nil {
+		return nil, errors.New("range must have at least one bound")
+	}
+
+	bounds := make(map[string]any, 2) //nolint:mnd
+
+	if r.Low != nil {
+		key := "gte"
+		if r.LowExclusive {
+			key = "gt"
+		}
+		bounds[key] = r.Low.Value()
+	}
+
+	if r.High != nil {
+		key := "lte"
+		if r.HighExclusive {
+			key = "lt"
+		}
+		bounds[key] = r.High.Value()
+	}
+
+	clause := single("range", field, bounds)
+	if op == query.NotEqual {
+		return mustNot(clause), nil
+	}
+
+	return clause, nil
+}
+
+// patternQuery applies the operator to the pattern query. `=`, `~` and `~*` mean that the field matches the pattern,
+// and `!=` negates it.
+func patternQuery(clause map[string]any, op query.FieldOperator) (map[string]any, error) {
+	switch op { //nolint:exhaustive
+	case query.Equal, query.Like, query.ILike:
+		return clause, nil
+	case query.NotEqual:
+		return mustNot(clause), nil
+	default:
+		return nil, fmt.Errorf("operator %q is not supported for pattern", op)
+	}
+}
+
+func wildcard(field, pattern string, caseInsensitive bool) map[string]any {
+	params := map[string]any{"value": pattern}
+	if caseInsensitive {
+		params["case_insensitive"] = true
+	}
+
+	return single("wildcard", field, params)
+}
+
+// regexpQuery returns `regexp` query. Note that Lucene regular expressions are always anchored and don't support
+// some of Go syntax, e.g. `^`, `$` and character classes like `\d`.
+func regexpQuery(field, pattern string, caseInsensitive bool) map[string]any {
+	params := map[string]any{"value": pattern}
+	if caseInsensitive {
+		params["case_insensitive"] = true
+	}
+
+	return single("regexp", field, params)
+}
+
+func exists(field string) map[string]any {
+	return map[string]any{"exists": map[string]any{"field": field}}
+}
+
+func mustNot(clause map[string]any) map[string]any {
+	return boolQuery("must_not", []any{clause})
+}
+
+func boolQuery(occur string, clauses []any) map[string]any {
+	return map[string]any{"bool": map[string]any{occur: clauses}}
+}
+
+// single returns query of the kind with parameters of the single field, e.g. {"term": {"status": "open"}}.
+func single(kind, field string, params any) map[string]any {
+	return map[string]any{kind: map[string]any{field: params}}
+}
+
+var wildcardEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`)
+
+// escapeWildcard escapes wildcard special characters, so the value is matched literally.
+func escapeWildcard(s string) string {
+	return wildcardEscaper.Replace(s)
+}
+
+// likeWildcard converts LIKE pattern with backslash as escape character into wildcard pattern.
+func likeWildcard(pattern string) string {
+	var (
+		sb      strings.Builder
+		escaped bool
+	)
+
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			sb.WriteString(escapeWildcard(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			sb.WriteByte('*')
+		case r == '_':
+			sb.WriteByte('?')
+		default:
+			sb.WriteString(escapeWildcard(string(r)))
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,203 @@
+package elastic_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/defer-panic/dumbql/elastic"
+	"github.com/defer-panic/dumbql/query"
+	"github.com/defer-panic/dumbql/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuery(t *testing.T) { //nolint:funlen
+	fields := schema.Fields{
+		"status":      {},
+		"age":         {},
+		"name":        {},
+		"title":       {Text: true, Searchable: true},
+		"description": {Text: true, Searchable: true},
+	}
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{
+			input: `status:open`,
+			want:  `{"term": {"status": "open"}}`,
+		},
+		{
+			input: `status:open and age >= 18 and age < 65`,
+			want: `{"bool": {"must": [
+				{"term": {"status": "open"}},
+				{"range": {"age": {"gte": 18}}},
+				{"range": {"age": {"lt": 65}}}
+			]}}`,
+		},
+		{
+			input: `(status:open or status:pending) and not name:John`,
+			want: `{"bool": {"must": [
+				{"bool": {"should": [{"term": {"status": "open"}}, {"term": {"status": "pending"}}]}},
+				{"bool": {"must_not": [{"term": {"name": "John"}}]}}
+			]}}`,
+		},
+		{
+			input: `status:[open, pending] and status != [closed]`,
+			want: `{"bool": {"must": [
+				{"terms": {"status": ["open", "pending"]}},
+				{"bool": {"must_not": [{"terms": {"status": ["closed"]}}]}}
+			]}}`,
+		},
+		{
+			input: `age:[18 TO 65}`,
+			want:  `{"range": {"age": {"gte": 18, "lt": 65}}}`,
+		},
+		{
+			input: `age != 18..65`,
+			want:  `{"bool": {"must_not": [{"range": {"age": {"gte": 18, "lte": 65}}}]}}`,
+		},
+		{
+			input: `name ~ "j*hn" or name ~* doe`,
+			want: `{"bool": {"should": [
+				{"wildcard": {"name": {"value": "*j\\*hn*"}}},
+				{"wildcard": {"name": {"value": "*doe*", "case_insensitive": true}}}
+			]}}`,
+		},
+		{
+			input: `name:J?hn* and name != /jo.n/i`,
+			want: `{"bool": {"must": [
+				{"wildcard": {"name": {"value": "J?hn*"}}},
+				{"bool": {"must_not": [{"regexp": {"name": {"value": "jo.n", "case_insensitive": true}}}]}}
+			]}}`,
+		},
+		{
+			input: `title:"broken pipe" and description ~ leak`,
+			want: `{"bool": {"must": [
+				{"match_phrase": {"title": "broken pipe"}},
+				{"match_phrase": {"description": "leak"}}
+			]}}`,
+		},
+		{
+			input: `title:[foo, bar]`,
+			want: `{"bool": {"should": [
+				{"match_phrase": {"title": "foo"}},
+				{"match_phrase": {"title": "bar"}}
+			]}}`,
+		},
+		{
+			input: `name:* and status != null and age:null`,
+			want: `{"bool": {"must": [
+				{"exists": {"field": "name"}},
+				{"bool": {"must_not": [{"bool": {"must_not": [{"exists": {"field": "status"}}]}}]}},
+				{"bool": {"must_not": [{"exists": {"field": "age"}}]}}
+			]}}`,
+		},
+		{
+			input: `created_at > 2024-01-01T10:00:00Z and is_active:true`,
+			want: `{"bool": {"must": [
+				{"range": {"created_at": {"gt": "2024-01-01T10:00:00Z"}}},
+				{"term": {"is_active": true}}
+			]}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			ast, err := query.Parse("test", []byte(test.input))
+			require.NoError(t, err)
+
+			got, err := elastic.Query(ast.(query.Expr), fields)
+			require.NoError(t, err)
+
+			data, err := json.Marshal(got)
+			require.NoError(t, err)
+			assert.JSONEq(t, test.want, string(data))
+		})
+	}
+}
+
+func TestQuery_Term(t *testing.T) {
+	fields := schema.Fields{
+		"title":  {Text: true, Searchable: true},
+		"tags":   {Searchable: true},
+		"status": {},
+	}
+
+	ast, err := query.Parse("test", []byte(`"broken pipe" and status:open`))
+	require.NoError(t, err)
+
+	_, err = elastic.Query(ast.(query.Expr), fields)
+	require.Error(t, err, "term is not validated")
+
+	expr, err := ast.(query.Expr).Validate(fields)
+	require.NoError(t, err)
+
+	got, err := elastic.Query(expr, fields)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(got)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"bool": {"must": [
+		{"bool": {"should": [
+			{"wildcard": {"tags": {"value": "*broken pipe*"}}},
+			{"match_phrase": {"title": "broken pipe"}}
+		]}},
+		{"term": {"status": "open"}}
+	]}}`, string(data))
+}
+
+func TestQuery_CaseInsensitive(t *testing.T) {
+	fields := schema.Fields{"email": {CaseInsensitive: true}}
+
+	ast, err := query.Parse("test", []byte(`email:"John@X.com" and email != [a, b]`))
+	require.NoError(t, err)
+
+	expr, err := ast.(query.Expr).Validate(fields)
+	require.NoError(t, err)
+
+	got, err := elastic.Query(expr, fields)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(got)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"bool": {"must": [
+		{"term": {"email": {"value": "John@X.com", "case_insensitive": true}}},
+		{"bool": {"must_not": [{"bool": {"should": [
+			{"term": {"email": {"value": "a", "case_insensitive": true}}},
+			{"term": {"email": {"value": "b", "case_insensitive": true}}}
+		]}}]}}
+	]}}`, string(data))
+}
+
+func TestQuery_RawLikePatterns(t *testing.T) {
+	ast, err := query.Parse("test", []byte(`name ~ "j_hn\\%%*"`), query.RawLikePatterns())
+	require.NoError(t, err)
+
+	got, err := elastic.Query(ast.(query.Expr), nil)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(got)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"wildcard": {"name": {"value": "j?hn%*\\*"}}}`, string(data))
+}
+
+func TestQuery_Error(t *testing.T) {
+	tests := []query.Expr{
+		&query.FieldExpr{Field: "age", Op: query.GreaterThan, Value: &query.RangeExpr{}},
+		&query.FieldExpr{Field: "age", Op: query.Equal, Value: &query.RangeExpr{}},
+		&query.FieldExpr{Field: "name", Op: query.Like, Value: &query.NullLiteral{}},
+		&query.FieldExpr{Field: "age", Op: query.GreaterThan, Value: &query.NullLiteral{}},
+		&query.FieldExpr{Field: "name", Op: query.GreaterThan, Value: &query.WildcardLiteral{Pattern: "x*"}},
+		&query.FieldExpr{Field: "name", Op: query.LessThan, Value: &query.OneOfExpr{}},
+		&query.NotExpr{Expr: &query.TermExpr{Term: "x"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.String(), func(t *testing.T) {
+			_, err := elastic.Query(test, nil)
+			require.Error(t, err)
+		})
+	}
+}
@@ -1,11 +1,17 @@
 package match
 
 import (
+	"math"
 	"reflect"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/defer-panic/dumbql/query"
 )
 
+var durationType = reflect.TypeOf(time.Duration(0))
+
 // StructMatcher is a basic implementation of the Matcher interface for evaluating query expressions against structs.
 // It supports struct tags using the `dumbql` tag name, which allows you to specify a custom field name.
 type StructMatcher struct{}
@@ -26,42 +32,138 @@ func (m *StructMatcher) MatchNot(target any, expr query.Expr) bool {
 // the `dumbql` tag name, which allows you to specify a custom field name. If struct tag is not provided, it will use
 // the field name as is.
 func (m *StructMatcher) MatchField(target any, field string, value query.Valuer, op query.FieldOperator) bool {
-	t := reflect.TypeOf(target)
+	v, ok := structValue(target)
+	if !ok {
+		return false
+	}
 
-	if t.Kind() == reflect.Ptr {
-		t = t.Elem()
+	fv, found := lookupField(v, field)
+	if !found {
+		return true // Unknown fields and fields marked with dumbql:"-" always match (do not affect the result)
 	}
 
-	if t.Kind() != reflect.Struct {
+	return m.MatchValue(fieldValue(fv), value, op)
+}
+
+// MatchExists checks if the target struct has the field and its value is not null. Unlike MatchField, unknown fields
+// and fields marked with dumbql:"-" never match.
+func (m *StructMatcher) MatchExists(target any, field string) bool {
+	v, ok := structValue(target)
+	if !ok {
 		return false
 	}
 
+	fv, found := lookupField(v, field)
+
+	return found && fieldValue(fv) != nil
+}
+
+func (m *StructMatcher) MatchValue(target any, value query.Valuer, op query.FieldOperator) bool {
+	return value.Match(target, op)
+}
+
+// fieldValue returns underlying value of the struct field. Pointers and interfaces are dereferenced, nil pointers,
+// interfaces, slices and maps are reported as nil (null). Values are converted to the types of query literals, like
+// schema.FromStruct declares them: integers of any size to int64, floats to float64, and named strings and booleans
+// to their underlying types. Unsigned integers greater than math.MaxInt64 are converted to float64.
+func fieldValue(v reflect.Value) any { //nolint:cyclop
+	if v.Type() == durationType {
+		return v.Interface()
+	}
+
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return fieldValue(v.Elem())
+	case reflect.Slice, reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u := v.Uint()
+		if u > math.MaxInt64 {
+			return float64(u)
+		}
+		return int64(u)
+	case reflect.Float32:
+		// Shortest representation of float32 is parsed, so 0.1 is 0.1 and not 0.10000000149011612.
+		f, _ := strconv.ParseFloat(strconv.FormatFloat(v.Float(), 'g', -1, 32), 64)
+		return f
+	case reflect.Float64:
+		return v.Float()
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return v.Bool()
+	}
+
+	return v.Interface()
+}
+
+func structValue(target any) (reflect.Value, bool) {
+	v := reflect.ValueOf(target)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	return v, v.Kind() == reflect.Struct
+}
+
+// lookupField finds the struct field by its query name. Fields marked with dumbql:"-" are skipped. Tag options after
+// the name (see schema.FromStruct) are ignored. Fields of nested structs are found by dotted names, e.g. profile.age.
+func lookupField(v reflect.Value, field string) (reflect.Value, bool) {
+	t := v.Type()
+
 	for i := 0; i < t.NumField(); i++ {
 		f := t.Field(i)
 
 		tag := f.Tag.Get("dumbql")
 		if tag == "-" {
-			return true // Field marked with dumbql:"-" always match (in other words does not affect the result)
+			continue
 		}
 
-		fname := f.Name
-		if tag != "" {
-			fname = tag
+		fname, _, _ := strings.Cut(tag, ",")
+		if fname == "" {
+			fname = f.Name
 		}
 
 		if fname == field {
-			v := reflect.ValueOf(target)
-			if v.Kind() == reflect.Ptr {
-				v = v.Elem()
-			}
+			return v.Field(i), true
+		}
 
-			return m.MatchValue(v.Field(i).Interface(), value, op)
+		if rest, ok := strings.CutPrefix(field, fname+"."); ok {
+			if fv, found := lookupNested(v.Field(i), rest); found {
+				return fv, true
+			}
 		}
 	}
 
-	return true
+	return reflect.Value{}, false
 }
 
-func (m *StructMatcher) MatchValue(target any, value query.Valuer, op query.FieldOperator) bool {
-	return value.Match(target, op)
+// lookupNested finds the field in the nested struct. If the pointer to the nested struct is nil, the pointer itself
+// is returned, so the field is null.
+func lookupNested(v reflect.Value, field string) (reflect.Value, bool) {
+	if v.Kind() == reflect.Ptr {
+		if v.Type().Elem().Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+
+		if v.IsNil() {
+			_, found := lookupField(reflect.New(v.Type().Elem()).Elem(), field)
+			return v, found
+		}
+
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	return lookupField(v, field)
 }
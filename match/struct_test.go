@@ -2,10 +2,13 @@ package match_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/defer-panic/dumbql/match"
 	"github.com/defer-panic/dumbql/query"
+	"github.com/defer-panic/dumbql/schema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type person struct {
@@ -248,6 +251,13 @@ func TestStructMatcher_MatchField(t *testing.T) {
 			op:    query.GreaterThan,
 			want:  true,
 		},
+		{
+			name:  "boolean equal match",
+			field: "IsMember",
+			value: &query.BooleanLiteral{BooleanValue: true},
+			op:    query.Equal,
+			want:  true,
+		},
 		{
 			name:  "non-existent field",
 			field: "invalid",
@@ -265,10 +275,333 @@ func TestStructMatcher_MatchField(t *testing.T) {
 	}
 }
 
+func TestStructMatcher_MatchField_Null(t *testing.T) { //nolint:funlen
+	type record struct {
+		DeletedAt *string        `dumbql:"deleted_at"`
+		ManagerID *int64         `dumbql:"manager_id"`
+		Extra     any            `dumbql:"extra"`
+		Tags      []string       `dumbql:"tags"`
+		Labels    map[string]int `dumbql:"labels"`
+	}
+
+	matcher := &match.StructMatcher{}
+	managerID := int64(42)
+
+	tests := []struct {
+		name   string
+		target record
+		field  string
+		op     query.FieldOperator
+		want   bool
+	}{
+		{
+			name:   "nil pointer is null",
+			target: record{},
+			field:  "deleted_at",
+			op:     query.Equal,
+			want:   true,
+		},
+		{
+			name:   "non-nil pointer is not null",
+			target: record{ManagerID: &managerID},
+			field:  "manager_id",
+			op:     query.NotEqual,
+			want:   true,
+		},
+		{
+			name:   "nil interface is null",
+			target: record{},
+			field:  "extra",
+			op:     query.Equal,
+			want:   true,
+		},
+		{
+			name:   "non-nil interface is not null",
+			target: record{Extra: "value"},
+			field:  "extra",
+			op:     query.Equal,
+			want:   false,
+		},
+		{
+			name:   "nil slice is null",
+			target: record{},
+			field:  "tags",
+			op:     query.Equal,
+			want:   true,
+		},
+		{
+			name:   "empty slice is not null",
+			target: record{Tags: []string{}},
+			field:  "tags",
+			op:     query.NotEqual,
+			want:   true,
+		},
+		{
+			name:   "nil map is null",
+			target: record{},
+			field:  "labels",
+			op:     query.Equal,
+			want:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := matcher.MatchField(test.target, test.field, &query.NullLiteral{}, test.op)
+			assert.Equal(t, test.want, result)
+		})
+	}
+
+	t.Run("pointer is dereferenced", func(t *testing.T) {
+		target := record{ManagerID: &managerID}
+		result := matcher.MatchField(target, "manager_id", &query.IntegerLiteral{IntegerValue: 42}, query.Equal)
+		assert.True(t, result)
+	})
+}
+
+func TestStructMatcher_MatchField_Time(t *testing.T) {
+	type record struct {
+		CreatedAt time.Time     `dumbql:"created_at"`
+		UpdatedAt *time.Time    `dumbql:"updated_at"`
+		TTL       time.Duration `dumbql:"ttl"`
+	}
+
+	matcher := &match.StructMatcher{}
+	createdAt := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	target := record{CreatedAt: createdAt, UpdatedAt: &createdAt, TTL: time.Hour}
+
+	tests := []struct {
+		name  string
+		field string
+		value query.Valuer
+		op    query.FieldOperator
+		want  bool
+	}{
+		{
+			name:  "time after date",
+			field: "created_at",
+			value: &query.TimeLiteral{TimeValue: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+			op:    query.GreaterThanOrEqual,
+			want:  true,
+		},
+		{
+			name:  "time pointer before date",
+			field: "updated_at",
+			value: &query.TimeLiteral{TimeValue: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+			op:    query.LessThan,
+			want:  false,
+		},
+		{
+			name:  "duration equal",
+			field: "ttl",
+			value: &query.DurationLiteral{DurationValue: 60 * time.Minute},
+			op:    query.Equal,
+			want:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := matcher.MatchField(target, test.field, test.value, test.op)
+			assert.Equal(t, test.want, result)
+		})
+	}
+}
+
+func TestStructMatcher_MatchExists(t *testing.T) {
+	type record struct {
+		Name     string  `dumbql:"name"`
+		Phone    *string `dumbql:"phone"`
+		Password string  `dumbql:"-"`
+		Internal bool
+	}
+
+	matcher := &match.StructMatcher{}
+	phone := "+1 555 0100"
+
+	tests := []struct {
+		name   string
+		target any
+		field  string
+		want   bool
+	}{
+		{
+			name:   "tagged field",
+			target: record{},
+			field:  "name",
+			want:   true,
+		},
+		{
+			name:   "field without dumbql tag",
+			target: &record{},
+			field:  "Internal",
+			want:   true,
+		},
+		{
+			name:   "non-nil pointer",
+			target: record{Phone: &phone},
+			field:  "phone",
+			want:   true,
+		},
+		{
+			name:   "nil pointer",
+			target: record{},
+			field:  "phone",
+			want:   false,
+		},
+		{
+			name:   "omitted field",
+			target: record{Password: "secret"},
+			field:  "Password",
+			want:   false,
+		},
+		{
+			name:   "non-existent field",
+			target: record{},
+			field:  "invalid",
+			want:   false,
+		},
+		{
+			name:   "non-struct target",
+			target: "name",
+			field:  "name",
+			want:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := matcher.MatchExists(test.target, test.field)
+			assert.Equal(t, test.want, result)
+		})
+	}
+}
+
+func TestStructMatcher_MatchField_Nested(t *testing.T) {
+	type address struct {
+		City string `dumbql:"city"`
+	}
+
+	type profile struct {
+		Age     int64    `dumbql:"age,min=0"`
+		Address *address `dumbql:"address"`
+	}
+
+	type user struct {
+		Profile profile `dumbql:"profile"`
+	}
+
+	matcher := &match.StructMatcher{}
+	target := user{Profile: profile{Age: 30, Address: &address{City: "Barcelona"}}}
+
+	tests := []struct {
+		name   string
+		target any
+		field  string
+		value  query.Valuer
+		want   bool
+	}{
+		{
+			name:   "field with tag options",
+			target: target,
+			field:  "profile.age",
+			value:  &query.IntegerLiteral{IntegerValue: 30},
+			want:   true,
+		},
+		{
+			name:   "nested pointer",
+			target: &target,
+			field:  "profile.address.city",
+			value:  &query.StringLiteral{StringValue: "Madrid"},
+			want:   false,
+		},
+		{
+			name:   "nil nested pointer is null",
+			target: user{},
+			field:  "profile.address.city",
+			value:  &query.NullLiteral{},
+			want:   true,
+		},
+		{
+			name:   "unknown nested field",
+			target: target,
+			field:  "profile.invalid",
+			value:  &query.StringLiteral{StringValue: "value"},
+			want:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := matcher.MatchField(test.target, test.field, test.value, query.Equal)
+			assert.Equal(t, test.want, result)
+		})
+	}
+}
+
+func TestStructMatcher_MatchField_FromStruct(t *testing.T) {
+	type status string
+
+	type item struct {
+		Count    int           `dumbql:"count"`
+		Level    int8          `dumbql:"level"`
+		Stock    uint32        `dumbql:"stock"`
+		Ratio    float32       `dumbql:"ratio"`
+		Status   status        `dumbql:"status"`
+		Timeout  time.Duration `dumbql:"timeout"`
+		Quantity *int          `dumbql:"quantity"`
+	}
+
+	fields, err := schema.FromStruct[item]()
+	require.NoError(t, err)
+
+	quantity := 3
+	target := item{
+		Count:    5,
+		Level:    -2,
+		Stock:    100,
+		Ratio:    0.1,
+		Status:   "open",
+		Timeout:  time.Minute,
+		Quantity: &quantity,
+	}
+
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{input: `count:5`, want: true},
+		{input: `count > 4 and count < 6.0`, want: true},
+		{input: `count:[1, 5]`, want: true},
+		{input: `count:6`, want: false},
+		{input: `level:-2`, want: true},
+		{input: `stock >= 100`, want: true},
+		{input: `ratio:0.1`, want: true},
+		{input: `ratio < 0.05`, want: false},
+		{input: `status:open`, want: true},
+		{input: `status ~ pe`, want: true},
+		{input: `timeout:1m`, want: true},
+		{input: `quantity:3`, want: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			ast, err := query.Parse("test", []byte(test.input))
+			require.NoError(t, err)
+
+			expr, err := ast.(query.Expr).Validate(fields)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.want, expr.Match(&target, &match.StructMatcher{}))
+		})
+	}
+}
+
 func TestStructMatcher_MatchValue(t *testing.T) {
 	t.Run("string", testMatchValueString)
 	t.Run("integer", testMatchValueInteger)
 	t.Run("float", testMatchValueFloat)
+	t.Run("boolean", testMatchValueBoolean)
 	t.Run("type mismatch", testMatchValueTypeMismatch)
 }
 
@@ -609,6 +942,53 @@ func testMatchValueFloat(t *testing.T) { //nolint:funlen
 	}
 }
 
+func testMatchValueBoolean(t *testing.T) {
+	matcher := &match.StructMatcher{}
+	tests := []struct {
+		name   string
+		target any
+		value  query.Valuer
+		op     query.FieldOperator
+		want   bool
+	}{
+		{
+			name:   "equal - match",
+			target: true,
+			value:  &query.BooleanLiteral{BooleanValue: true},
+			op:     query.Equal,
+			want:   true,
+		},
+		{
+			name:   "equal - no match",
+			target: false,
+			value:  &query.BooleanLiteral{BooleanValue: true},
+			op:     query.Equal,
+			want:   false,
+		},
+		{
+			name:   "not equal - match",
+			target: false,
+			value:  &query.BooleanLiteral{BooleanValue: true},
+			op:     query.NotEqual,
+			want:   true,
+		},
+		{
+			name:   "greater than - invalid",
+			target: true,
+			value:  &query.BooleanLiteral{BooleanValue: false},
+			op:     query.GreaterThan,
+			want:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := matcher.MatchValue(test.target, test.value, test.op)
+			assert.Equal(t, test.want, result)
+		})
+	}
+}
+
 func testMatchValueTypeMismatch(t *testing.T) {
 	matcher := &match.StructMatcher{}
 	tests := []struct {
@@ -632,6 +1012,13 @@ func testMatchValueTypeMismatch(t *testing.T) {
 			op:     query.Equal,
 			want:   false,
 		},
+		{
+			name:   "boolean target with string value",
+			target: true,
+			value:  &query.StringLiteral{StringValue: "true"},
+			op:     query.Equal,
+			want:   false,
+		},
 		{
 			name:   "float target with string value",
 			target: 3.14,
@@ -0,0 +1,314 @@
+// Package mongo converts query expressions into MongoDB filter documents.
+package mongo
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/defer-panic/dumbql/query"
+)
+
+// Filter converts the expression into MongoDB filter document, which can be passed to the driver as bson.M:
+//
+//   - `and` and `or` become `$and` and `$or`, chains of the same operator are flattened
+//   - `not` becomes `$nor`
+//   - comparisons become `$eq`, `$ne`, `$gt`, `$gte`, `$lt` and `$lte`
+//   - one-of becomes `$in` (`$nin` for none of)
+//   - `~` and `~*` become `$regex` with escaped value, wildcards and LIKE patterns are converted into regular
+//     expressions as well
+//   - field existence (`field:*`) checks that the field exists and isn't null
+//
+// Free text terms must be expanded by validation first.
+func Filter(expr query.Expr) (map[string]any, error) {
+	switch e := expr.(type) {
+	case *query.BinaryExpr:
+		return binaryFilter(e)
+	case *query.NotExpr:
+		inner, err := Filter(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]any{"$nor": []any{inner}}, nil
+	case *query.FieldExpr:
+		return fieldFilter(e)
+	case *query.TermExpr:
+		if len(e.Fields) == 0 {
+			return nil, fmt.Errorf("term %q: no fields to search", e.Term)
+		}
+
+		or := make([]any, 0, len(e.Fields))
+		for _, f := range e.Fields {
+			filter, err := fieldFilter(f)
+			if err != nil {
+				return nil, err
+			}
+			or = append(or, filter)
+		}
+
+		return map[string]any{"$or": or}, nil
+	default:
+		return nil, fmt.Errorf("unsupported expression %T", expr)
+	}
+}
+
+func binaryFilter(b *query.BinaryExpr) (map[string]any, error) {
+	var key string
+
+	switch b.Op {
+	case query.And:
+		key = "$and"
+	case query.Or:
+		key = "$or"
+	default:
+		return nil, fmt.Errorf("unknown operator %q", b.Op)
+	}
+
+	var operands []any
+
+	for _, operand := range []query.Expr{b.Left, b.Right} {
+		filter, err := Filter(operand)
+		if err != nil {
+			return nil, err
+		}
+
+		// Flatten `a and b and c` into a single $and.
+		if nested, ok := operand.(*query.BinaryExpr); ok && nested.Op == b.Op {
+			operands = append(operands, filter[key].([]any)...)
+			continue
+		}
+
+		operands = append(operands, filter)
+	}
+
+	return map[string]any{key: operands}, nil
+}
+
+func fieldFilter(f *query.FieldExpr) (map[string]any, error) { //nolint:cyclop
+	field := f.Field.String()
+	if field == "" || strings.HasPrefix(field, "$") {
+		return nil, fmt.Errorf("invalid field name %q", field)
+	}
+
+	if f.Op == query.Exists {
+		return map[string]any{field: map[string]any{"$exists": true, "$ne": nil}}, nil
+	}
+
+	var (
+		cond any
+		err  error
+	)
+
+	switch v := f.Value.(type) {
+	case *query.RangeExpr:
+		cond, err = rangeCondition(v, f.Op)
+	case *query.OneOfExpr:
+		if f.Op == query.Like || f.Op == query.ILike || hasFoldedString(v) {
+			return oneOfPatternsFilter(f, v)
+		}
+		cond, err = oneOfCondition(v, f.Op)
+	case *query.StringLiteral:
+		if v.CaseInsensitive && (f.Op == query.Equal || f.Op == query.NotEqual) {
+			// Equality ignoring case is an anchored case-insensitive regular expression.
+			cond, err = patternCondition("^"+regexp.QuoteMeta(v.StringValue)+"$", true, f.Op)
+			break
+		}
+		cond, err = valueCondition(v.Value(), f.Op)
+	case *query.WildcardLiteral:
+		cond, err = patternCondition(wildcardRegex(v.Pattern), false, f.Op)
+	case *query.LikePatternLiteral:
+		cond, err = patternCondition(likeRegex(v.Pattern), false, f.Op)
+	case *query.RegexLiteral:
+		cond, err = patternCondition(v.Pattern, v.CaseInsensitive, f.Op)
+	default:
+		cond, err = valueCondition(v.Value(), f.Op)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{field: cond}, nil
+}
+
+var comparisonOperators = map[query.FieldOperator]string{
+	query.Equal:              "$eq",
+	query.NotEqual:           "$ne",
+	query.GreaterThan:        "$gt",
+	query.GreaterThanOrEqual: "$gte",
+	query.LessThan:           "$lt",
+	query.LessThanOrEqual:    "$lte",
+}
+
+func valueCondition(value any, op query.FieldOperator) (any, error) {
+	if value == nil && op != query.Equal && op != query.NotEqual {
+		return nil, fmt.Errorf("operator %q is not supported for null", op)
+	}
+
+	switch op { //nolint:exhaustive
+	case query.Like, query.ILike:
+		return patternCondition(regexp.QuoteMeta(fmt.Sprint(value)), false, op)
+	}
+
+	key, ok := comparisonOperators[op]
+	if !ok {
+		return nil, fmt.Errorf("unknown operator %q", op)
+	}
+
+	return map[string]any{key: value}, nil
+}
+
+// oneOfCondition converts one-of into `$in`, or into `$nin` for none of.
+func oneOfCondition(oneOf *query.OneOfExpr, op query.FieldOperator) (any, error) {
+	values := make([]any, 0, len(oneOf.Values))
+	for _, v := range oneOf.Values {
+		values = append(values, v.Value())
+	}
+
+	switch op { //nolint:exhaustive
+	case query.Equal:
+		return map[string]any{"$in": values}, nil
+	case query.NotEqual:
+		return map[string]any{"$nin": values}, nil
+	default:
+		return nil, fmt.Errorf("operator %q is not supported for one-of", op)
+	}
+}
+
+// oneOfPatternsFilter converts `~` and `~*` with one-of, or one-of with case-insensitive strings, into `$or` of
+// regular expressions matching any of the values. None of (`!=`) becomes `$nor`.
+func oneOfPatternsFilter(f *query.FieldExpr, oneOf *query.OneOfExpr) (map[string]any, error) {
+	op, key := f.Op, "$or"
+	if op == query.NotEqual {
+		op, key = query.Equal, "$nor"
+	}
+
+	or := make([]any, 0, len(oneOf.Values))
+
+	for _, v := range oneOf.Values {
+		filter, err := fieldFilter(&query.FieldExpr{Field: f.Field, Op: op, Value: v})
+		if err != nil {
+			return nil, err
+		}
+		or = append(or, filter)
+	}
+
+	return map[string]any{key: or}, nil
+}
+
+// hasFoldedString reports whether one-of has strings compared ignoring case.
+func hasFoldedString(oneOf *query.OneOfExpr) bool {
+	for _, v := range oneOf.Values {
+		if s, ok := v.(*query.StringLiteral); ok && s.CaseInsensitive {
+			return true
+		}
+	}
+
+	return false
+}
+
+func rangeCondition(r *query.RangeExpr, op query.FieldOperator) (any, error) {
+	if op != query.Equal && op != query.NotEqual {
+		return nil, fmt.Errorf("operator %q is not supported for range", op)
+	}
+
+	if r.Low == nil && r.High == nil {
+		return nil, errors.New("range must have at least one bound")
+	}
+
+	cond := make(map[string]any, 2) //nolint:mnd
+
+	if r.Low != nil {
+		key := "$gte"
+		if r.LowExclusive {
+			key = "$gt"
+		}
+		cond[key] = r.Low.Value()
+	}
+
+	if r.High != nil {
+		key := "$lte"
+		if r.HighExclusive {
+			key = "$lt"
+		}
+		cond[key] = r.High.Value()
+	}
+
+	if op == query.NotEqual {
+		return map[string]any{"$not": cond}, nil
+	}
+
+	return cond, nil
+}
+
+// patternCondition converts regular expression according to the operator. `=`, `~` and `~*` mean that the field
+// matches the pattern, and `!=` negates it. `~*` makes the pattern case-insensitive.
+func patternCondition(pattern string, caseInsensitive bool, op query.FieldOperator) (any, error) {
+	cond := map[string]any{"$regex": pattern}
+	if caseInsensitive || op == query.ILike {
+		cond["$options"] = "i"
+	}
+
+	switch op { //nolint:exhaustive
+	case query.Equal, query.Like, query.ILike:
+		return cond, nil
+	case query.NotEqual:
+		return map[string]any{"$not": cond}, nil
+	default:
+		return nil, fmt.Errorf("operator %q is not supported for pattern", op)
+	}
+}
+
+// wildcardRegex converts the glob pattern into anchored regular expression.
+func wildcardRegex(pattern string) string {
+	var sb strings.Builder
+
+	sb.WriteByte('^')
+
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteByte('.')
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+
+	sb.WriteByte('$')
+
+	return sb.String()
+}
+
+// likeRegex converts LIKE pattern with backslash as escape character into anchored regular expression.
+func likeRegex(pattern string) string {
+	var (
+		sb      strings.Builder
+		escaped bool
+	)
+
+	sb.WriteByte('^')
+
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			sb.WriteString(".*")
+		case r == '_':
+			sb.WriteByte('.')
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+
+	sb.WriteByte('$')
+
+	return sb.String()
+}
@@ -0,0 +1,23 @@
+package mongo_test
+
+import (
+	"fmt"
+
+	"github.com/defer-panic/dumbql"
+	"github.com/defer-panic/dumbql/mongo"
+)
+
+func ExampleFilter() {
+	expr, err := dumbql.Parse(`status:[pending, approved] and not name ~ "john"`)
+	if err != nil {
+		panic(err)
+	}
+
+	filter, err := mongo.Filter(expr.Expr)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(filter)
+	// Output: map[$and:[map[status:map[$in:[pending approved]]] map[$nor:[map[name:map[$regex:john]]]]]]
+}
@@ -0,0 +1,178 @@
+package mongo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/defer-panic/dumbql/mongo"
+	"github.com/defer-panic/dumbql/query"
+	"github.com/defer-panic/dumbql/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type M = map[string]any
+
+func TestFilter(t *testing.T) { //nolint:funlen
+	tests := []struct {
+		input string
+		want  M
+	}{
+		{
+			input: `status:open`,
+			want:  M{"status": M{"$eq": "open"}},
+		},
+		{
+			input: `status:open and age >= 18 and score < 4.5`,
+			want: M{"$and": []any{
+				M{"status": M{"$eq": "open"}},
+				M{"age": M{"$gte": int64(18)}},
+				M{"score": M{"$lt": 4.5}},
+			}},
+		},
+		{
+			input: `(age > 18 or age <= 10) and name != John`,
+			want: M{"$and": []any{
+				M{"$or": []any{
+					M{"age": M{"$gt": int64(18)}},
+					M{"age": M{"$lte": int64(10)}},
+				}},
+				M{"name": M{"$ne": "John"}},
+			}},
+		},
+		{
+			input: `not is_active:true`,
+			want:  M{"$nor": []any{M{"is_active": M{"$eq": true}}}},
+		},
+		{
+			input: `status:[open, pending]`,
+			want:  M{"status": M{"$in": []any{"open", "pending"}}},
+		},
+		{
+			input: `status != [open, pending]`,
+			want:  M{"status": M{"$nin": []any{"open", "pending"}}},
+		},
+		{
+			input: `name ~ "j.doe (admin)"`,
+			want:  M{"name": M{"$regex": `j\.doe \(admin\)`}},
+		},
+		{
+			input: `name ~* [john, jane]`,
+			want: M{"$or": []any{
+				M{"name": M{"$regex": "john", "$options": "i"}},
+				M{"name": M{"$regex": "jane", "$options": "i"}},
+			}},
+		},
+		{
+			input: `name:J?hn.*`,
+			want:  M{"name": M{"$regex": `^J.hn\..*$`}},
+		},
+		{
+			input: `name != /^jo.n$/i`,
+			want:  M{"name": M{"$not": M{"$regex": "^jo.n$", "$options": "i"}}},
+		},
+		{
+			input: `age:[18 TO 65}`,
+			want:  M{"age": M{"$gte": int64(18), "$lt": int64(65)}},
+		},
+		{
+			input: `age != 18..65`,
+			want:  M{"age": M{"$not": M{"$gte": int64(18), "$lte": int64(65)}}},
+		},
+		{
+			input: `created_at > 2024-01-01 and deleted_at:null and email:*`,
+			want: M{"$and": []any{
+				M{"created_at": M{"$gt": time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}},
+				M{"deleted_at": M{"$eq": nil}},
+				M{"email": M{"$exists": true, "$ne": nil}},
+			}},
+		},
+		{
+			input: `profile.age >= 18`,
+			want:  M{"profile.age": M{"$gte": int64(18)}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			ast, err := query.Parse("test", []byte(test.input))
+			require.NoError(t, err)
+
+			got, err := mongo.Filter(ast.(query.Expr))
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestFilter_RawLikePatterns(t *testing.T) {
+	ast, err := query.Parse("test", []byte(`name ~* "j_hn\\%%"`), query.RawLikePatterns())
+	require.NoError(t, err)
+
+	got, err := mongo.Filter(ast.(query.Expr))
+	require.NoError(t, err)
+	assert.Equal(t, M{"name": M{"$regex": `^j.hn%.*$`, "$options": "i"}}, got)
+}
+
+func TestFilter_Term(t *testing.T) {
+	fields := schema.Fields{
+		"title": {Searchable: true},
+		"body":  {Searchable: true, CaseInsensitive: true},
+	}
+
+	ast, err := query.Parse("test", []byte(`"broken pipe"`))
+	require.NoError(t, err)
+
+	_, err = mongo.Filter(ast.(query.Expr))
+	require.Error(t, err, "term is not validated")
+
+	expr, err := ast.(query.Expr).Validate(fields)
+	require.NoError(t, err)
+
+	got, err := mongo.Filter(expr)
+	require.NoError(t, err)
+	assert.Equal(t, M{"$or": []any{
+		M{"body": M{"$regex": "broken pipe", "$options": "i"}},
+		M{"title": M{"$regex": "broken pipe"}},
+	}}, got)
+}
+
+func TestFilter_CaseInsensitive(t *testing.T) {
+	fields := schema.Fields{"email": {CaseInsensitive: true}}
+
+	ast, err := query.Parse("test", []byte(`email:"John@X.com" and email != [a.b, c]`))
+	require.NoError(t, err)
+
+	expr, err := ast.(query.Expr).Validate(fields)
+	require.NoError(t, err)
+
+	got, err := mongo.Filter(expr)
+	require.NoError(t, err)
+	assert.Equal(t, M{"$and": []any{
+		M{"email": M{"$regex": `^John@X\.com$`, "$options": "i"}},
+		M{"$nor": []any{
+			M{"email": M{"$regex": `^a\.b$`, "$options": "i"}},
+			M{"email": M{"$regex": `^c$`, "$options": "i"}},
+		}},
+	}}, got)
+}
+
+func TestFilter_Error(t *testing.T) {
+	tests := []query.Expr{
+		&query.FieldExpr{Field: "$where", Op: query.Equal, Value: &query.StringLiteral{StringValue: "x"}},
+		&query.FieldExpr{Field: "age", Op: query.GreaterThan, Value: &query.RangeExpr{}},
+		&query.FieldExpr{Field: "age", Op: query.Equal, Value: &query.RangeExpr{}},
+		&query.FieldExpr{Field: "name", Op: query.Like, Value: &query.NullLiteral{}},
+		&query.FieldExpr{Field: "age", Op: query.GreaterThan, Value: &query.NullLiteral{}},
+		&query.FieldExpr{Field: "name", Op: query.GreaterThan, Value: &query.RegexLiteral{Pattern: "x"}},
+		&query.FieldExpr{Field: "name", Op: query.LessThan, Value: &query.OneOfExpr{}},
+		&query.NotExpr{Expr: &query.TermExpr{Term: "x"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.String(), func(t *testing.T) {
+			_, err := mongo.Filter(test)
+			require.Error(t, err)
+		})
+	}
+}
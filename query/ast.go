@@ -2,7 +2,10 @@ package query
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
+	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/defer-panic/dumbql/schema"
@@ -14,8 +17,10 @@ type Expr interface {
 	fmt.Stringer
 	sq.Sqlizer
 
+	ToSqlDialect(d Dialect) (string, []any, error)
+	Pos() Span
 	Match(target any, matcher Matcher) bool
-	Validate(schema.Schema) (Expr, error)
+	Validate(schema.Definition) (Expr, error)
 }
 
 type Valuer interface {
@@ -23,11 +28,30 @@ type Valuer interface {
 	Match(target any, op FieldOperator) bool
 }
 
+// Span is a range of byte offsets [Start, End) of the node in the query. It's zero for nodes built manually.
+type Span struct {
+	Start int
+	End   int
+}
+
+// Pos returns the node span.
+func (s Span) Pos() Span { return s }
+
+func (s *Span) setSpan(span Span) { *s = span }
+
+// source is the text of the literal as it's written in the query. It's empty for literals built manually.
+type source struct {
+	text string
+}
+
+func (s source) sourceText() string { return s.text }
+
 // BinaryExpr represents a binary operation (`and`, `or`, `AND`, `OR`) between two expressions.
 type BinaryExpr struct {
 	Left  Expr
 	Op    BooleanOperator // `and` or `or`
 	Right Expr
+	Span
 }
 
 func (b *BinaryExpr) String() string {
@@ -37,26 +61,48 @@ func (b *BinaryExpr) String() string {
 // NotExpr represents a NOT expression.
 type NotExpr struct {
 	Expr Expr
+	Span
 }
 
 func (n *NotExpr) String() string {
 	return fmt.Sprintf("(not %s)", n.Expr)
 }
 
-// FieldExpr represents a field query, e.g. status:200.
+// FieldExpr represents a field query, e.g. status:200. Value is nil for the Exists operator (field:*).
 type FieldExpr struct {
 	Field Identifier
 	Op    FieldOperator
 	Value Valuer
+	Span
 }
 
 func (f *FieldExpr) String() string {
+	if f.Op == Exists {
+		return fmt.Sprintf("(%s %s)", f.Op, f.Field)
+	}
+
 	return fmt.Sprintf("(%s %s %v)", f.Op, f.Field, f.Value)
 }
 
-// StringLiteral represents a bare term (a free text search term).
+// TermExpr represents a free text search term: a bare word (error) or a quoted phrase ("broken pipe") without field
+// name. Validation fills Fields with `~` expressions for every searchable field of the schema, and the term matches
+// if any of them does.
+type TermExpr struct {
+	Term   string
+	Fields []*FieldExpr
+	Span
+}
+
+func (t *TermExpr) String() string {
+	return fmt.Sprintf("(term %q)", t.Term)
+}
+
+// StringLiteral represents a string value, quoted or bare. CaseInsensitive is set by validation for fields with
+// case-insensitive matching, then `=`, `!=` and one-of compare the string ignoring case.
 type StringLiteral struct {
-	StringValue string
+	StringValue     string
+	CaseInsensitive bool
+	Span
 }
 
 func (s *StringLiteral) String() string { return strconv.Quote(s.StringValue) }
@@ -64,6 +110,8 @@ func (s *StringLiteral) Value() any     { return s.StringValue }
 
 type NumberLiteral struct {
 	NumberValue float64
+	Span
+	source
 }
 
 func (n *NumberLiteral) String() string { return fmt.Sprintf("%f", n.NumberValue) }
@@ -71,6 +119,8 @@ func (n *NumberLiteral) Value() any     { return n.NumberValue }
 
 type IntegerLiteral struct {
 	IntegerValue int64
+	Span
+	source
 }
 
 func (i *IntegerLiteral) String() string { return strconv.FormatInt(i.IntegerValue, 10) }
@@ -83,6 +133,7 @@ func (i Identifier) String() string { return string(i) }
 
 type OneOfExpr struct {
 	Values []Valuer
+	Span
 }
 
 func (o *OneOfExpr) String() string { return fmt.Sprintf("%v", o.Values) }
@@ -97,6 +148,233 @@ func (o *OneOfExpr) Value() any {
 	return vals
 }
 
+// BooleanLiteral represents a `true` or `false` value.
+type BooleanLiteral struct {
+	BooleanValue bool
+	Span
+	source
+}
+
+func (b *BooleanLiteral) String() string { return strconv.FormatBool(b.BooleanValue) }
+func (b *BooleanLiteral) Value() any     { return b.BooleanValue }
+
+// NullLiteral represents a `null` value. It can only be compared with `=` and `!=`, which are
+// translated to `IS NULL` and `IS NOT NULL` respectively.
+type NullLiteral struct {
+	Span
+}
+
+func (n *NullLiteral) String() string { return "null" }
+func (n *NullLiteral) Value() any     { return nil }
+
+// TimeLiteral represents an RFC3339 date (2024-01-01) or date and time (2024-01-01T10:00:00Z) value.
+// Date without time is treated as midnight UTC.
+type TimeLiteral struct {
+	TimeValue time.Time
+	Span
+	source
+}
+
+func (t *TimeLiteral) String() string { return t.TimeValue.Format(time.RFC3339Nano) }
+func (t *TimeLiteral) Value() any     { return t.TimeValue }
+
+// RelativeTimeLiteral represents a point in time relative to the current moment, e.g. now-7d.
+// The moment is taken from Now function which is set from the Clock parser option, time.Now is used by default.
+type RelativeTimeLiteral struct {
+	Offset time.Duration
+	Now    func() time.Time
+	Span
+	source
+}
+
+func (r *RelativeTimeLiteral) String() string {
+	switch {
+	case r.Offset > 0:
+		return "now+" + formatDuration(r.Offset)
+	case r.Offset < 0:
+		return "now-" + formatDuration(-r.Offset)
+	default:
+		return "now"
+	}
+}
+
+func (r *RelativeTimeLiteral) Value() any { return r.Time() }
+
+// Time resolves the literal to an absolute point in time.
+func (r *RelativeTimeLiteral) Time() time.Time {
+	now := r.Now
+	if now == nil {
+		now = time.Now
+	}
+
+	return now().Add(r.Offset)
+}
+
+// DurationLiteral represents a duration value, e.g. 1h30m. Supported units are ns, us, ms, s, m, h, d (days)
+// and w (weeks).
+type DurationLiteral struct {
+	DurationValue time.Duration
+	Span
+	source
+}
+
+func (d *DurationLiteral) String() string {
+	if d.DurationValue < 0 {
+		return "-" + formatDuration(-d.DurationValue)
+	}
+
+	return formatDuration(d.DurationValue)
+}
+
+func (d *DurationLiteral) Value() any { return d.DurationValue }
+
+// RangeExpr represents a range of values, e.g. [18 TO 65] or 18..65. Square brackets denote inclusive bounds and curly
+// braces denote exclusive ones, so {18 TO 65] excludes 18 and includes 65. Dotted form is always inclusive.
+// Nil bound (written as *) means that the range is unbounded from that side.
+type RangeExpr struct {
+	Low           Valuer
+	High          Valuer
+	LowExclusive  bool
+	HighExclusive bool
+	Span
+}
+
+func (r *RangeExpr) String() string {
+	left, right := "[", "]"
+	if r.LowExclusive {
+		left = "{"
+	}
+	if r.HighExclusive {
+		right = "}"
+	}
+
+	return fmt.Sprintf("%s%s TO %s%s", left, rangeBoundString(r.Low), rangeBoundString(r.High), right)
+}
+
+func (r *RangeExpr) Value() any {
+	var low, high any
+
+	if r.Low != nil {
+		low = r.Low.Value()
+	}
+
+	if r.High != nil {
+		high = r.High.Value()
+	}
+
+	return []any{low, high}
+}
+
+func rangeBoundString(v Valuer) string {
+	if v == nil {
+		return "*"
+	}
+
+	return fmt.Sprint(v)
+}
+
+// WildcardLiteral represents a glob pattern, where `*` matches any sequence of characters and `?` matches any
+// single character. It covers prefix (John*), suffix (*son) and arbitrary wildcard (J?hn*) searches.
+type WildcardLiteral struct {
+	Pattern string
+	Span
+}
+
+func (w *WildcardLiteral) String() string { return w.Pattern }
+func (w *WildcardLiteral) Value() any     { return w.Pattern }
+
+// LikePattern converts the glob pattern into LIKE pattern with backslash as escape character.
+func (w *WildcardLiteral) LikePattern() string {
+	var sb strings.Builder
+
+	for _, r := range w.Pattern {
+		switch r {
+		case '*':
+			sb.WriteByte('%')
+		case '?':
+			sb.WriteByte('_')
+		default:
+			sb.WriteString(escapeLike(string(r)))
+		}
+	}
+
+	return sb.String()
+}
+
+// LikePatternLiteral represents a raw LIKE pattern, where `%` matches any sequence of characters, `_` matches any
+// single character and backslash escapes them. Values of `~` and `~*` are parsed as such patterns when
+// RawLikePatterns option is set.
+type LikePatternLiteral struct {
+	Pattern string
+	Span
+}
+
+func (l *LikePatternLiteral) String() string { return strconv.Quote(l.Pattern) }
+func (l *LikePatternLiteral) Value() any     { return l.Pattern }
+
+// RegexLiteral represents a regular expression, e.g. /^jo.n$/i. The only supported flag is `i` (case-insensitive).
+// Note that each backend evaluates the pattern with its native engine: Go's regexp package for matching and the
+// database one for SQL.
+type RegexLiteral struct {
+	Pattern         string
+	CaseInsensitive bool
+	Span
+
+	// re and foldRe are the pattern compiled by the parser as is and case-insensitively.
+	re, foldRe *regexp.Regexp
+}
+
+func (r *RegexLiteral) String() string {
+	s := "/" + strings.ReplaceAll(r.Pattern, "/", `\/`) + "/"
+	if r.CaseInsensitive {
+		s += "i"
+	}
+
+	return s
+}
+
+func (r *RegexLiteral) Value() any { return r.Pattern }
+
+// Regexp returns the pattern compiled taking flags into account. Literals created by the parser are compiled once,
+// others are compiled on each call.
+func (r *RegexLiteral) Regexp() (*regexp.Regexp, error) {
+	if r.CaseInsensitive {
+		return r.foldRegexp()
+	}
+
+	if r.re != nil {
+		return r.re, nil
+	}
+
+	return regexp.Compile(r.Pattern)
+}
+
+// foldRegexp returns the pattern compiled case-insensitively regardless of the flag, as `~*` requires.
+func (r *RegexLiteral) foldRegexp() (*regexp.Regexp, error) {
+	if r.foldRe != nil {
+		return r.foldRe, nil
+	}
+
+	return regexp.Compile("(?i)" + r.Pattern)
+}
+
+// compile compiles the pattern, so matching doesn't compile it again for each target.
+func (r *RegexLiteral) compile() error {
+	re, err := regexp.Compile(r.Pattern)
+	if err != nil {
+		return err
+	}
+
+	foldRe, err := regexp.Compile("(?i)" + r.Pattern)
+	if err != nil {
+		return err
+	}
+
+	r.re, r.foldRe = re, foldRe
+
+	return nil
+}
+
 type BooleanOperator uint8
 
 const (
@@ -125,6 +403,8 @@ const (
 	LessThan
 	LessThanOrEqual
 	Like
+	ILike
+	Exists
 )
 
 func (c FieldOperator) String() string {
@@ -143,6 +423,10 @@ func (c FieldOperator) String() string {
 		return "<="
 	case Like:
 		return "~"
+	case ILike:
+		return "~*"
+	case Exists:
+		return "exists"
 	default:
 		return "unknown!"
 	}
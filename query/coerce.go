@@ -0,0 +1,221 @@
+package query
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/defer-panic/dumbql/schema"
+	"go.uber.org/multierr"
+)
+
+// coerce returns copy of the expression with values converted to the declared type of the field.
+// Invalid values of one-of are dropped, the whole expression is dropped if its value or any range bound is invalid.
+func (f *FieldExpr) coerce(field schema.Field, typ schema.Type) (*FieldExpr, error) {
+	if typ == schema.TypeAny || f.Op == Exists {
+		return f, nil
+	}
+
+	res := *f
+
+	switch v := f.Value.(type) {
+	case *RangeExpr:
+		r := *v
+
+		var err error
+		for _, bound := range []*Valuer{&r.Low, &r.High} {
+			if *bound == nil {
+				continue
+			}
+
+			coerced, coerceErr := coerceValue(field, typ, *bound)
+			err = multierr.Append(err, withSpan(valueSpan(*bound, f.Span), coerceErr))
+			*bound = coerced
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		res.Value = &r
+	case *OneOfExpr:
+		var (
+			values = make([]Valuer, 0, len(v.Values))
+			err    error
+		)
+
+		for _, val := range v.Values {
+			coerced, coerceErr := coerceValue(field, typ, val)
+			if coerceErr != nil {
+				err = multierr.Append(err, withSpan(valueSpan(val, f.Span), coerceErr))
+				continue
+			}
+			values = append(values, coerced)
+		}
+
+		res.Value = &OneOfExpr{Values: values, Span: v.Span}
+
+		return &res, err
+	default:
+		coerced, err := coerceValue(field, typ, v)
+		if err != nil {
+			return nil, withSpan(f.Span, err)
+		}
+
+		res.Value = coerced
+	}
+
+	return &res, nil
+}
+
+// coerceValue converts the literal to the type. Null and values of the type are returned as is.
+// Strings are converted only from string literals, so patterns and regular expressions are never treated as numbers.
+func coerceValue(field schema.Field, typ schema.Type, v Valuer) (Valuer, error) {
+	val := v.Value()
+	if val == nil {
+		return v, nil
+	}
+
+	var (
+		res Valuer
+		ok  bool
+	)
+
+	span := valueSpan(v, Span{})
+
+	switch typ { //nolint:exhaustive
+	case schema.TypeString:
+		res, ok = coerceString(v, span)
+	case schema.TypeInt64:
+		res, ok = coerceInt64(v, span)
+	case schema.TypeFloat64:
+		res, ok = coerceFloat64(v, span)
+	case schema.TypeBool:
+		res, ok = coerceBool(v, span)
+	case schema.TypeTime:
+		res, ok = coerceTime(v, span)
+	case schema.TypeDuration:
+		res, ok = coerceDuration(v, span)
+	default:
+		return v, nil
+	}
+
+	if !ok {
+		return nil, &schema.ValidationError{
+			Field:    field,
+			Rule:     "type",
+			Code:     schema.CodeType,
+			Expected: typ,
+			Actual:   fmt.Sprintf("%T", val),
+		}
+	}
+
+	return res, nil
+}
+
+// stringLiteral returns the value of string literal or identifier.
+func stringLiteral(v Valuer) (string, bool) {
+	switch s := v.(type) {
+	case *StringLiteral:
+		return s.StringValue, true
+	case Identifier:
+		return string(s), true
+	default:
+		return "", false
+	}
+}
+
+// coerceString converts the literal to string. Literals parsed from the query give their text as it's written, e.g.
+// `code:1.50` gives "1.50" and `sku:2024-01-01` gives "2024-01-01". Literals built manually are formatted.
+func coerceString(v Valuer, span Span) (Valuer, bool) {
+	if s, hasSource := v.(interface{ sourceText() string }); hasSource && s.sourceText() != "" {
+		return &StringLiteral{StringValue: s.sourceText(), Span: span}, true
+	}
+
+	switch val := v.Value().(type) {
+	case string:
+		if id, isIdentifier := v.(Identifier); isIdentifier {
+			return &StringLiteral{StringValue: string(id), Span: span}, true
+		}
+		return v, true
+	case time.Time, time.Duration:
+		return &StringLiteral{StringValue: fmt.Sprint(v), Span: span}, true
+	case int64:
+		return &StringLiteral{StringValue: strconv.FormatInt(val, 10), Span: span}, true
+	case float64:
+		return &StringLiteral{StringValue: strconv.FormatFloat(val, 'f', -1, 64), Span: span}, true
+	case bool:
+		return &StringLiteral{StringValue: strconv.FormatBool(val), Span: span}, true
+	default:
+		return nil, false
+	}
+}
+
+func coerceInt64(v Valuer, span Span) (Valuer, bool) {
+	if s, ok := stringLiteral(v); ok {
+		n, err := strconv.ParseInt(s, 10, 64)
+		return &IntegerLiteral{IntegerValue: n, Span: span}, err == nil
+	}
+
+	switch val := v.Value().(type) {
+	case int64:
+		return v, true
+	case float64:
+		if val != math.Trunc(val) || val < math.MinInt64 || val >= math.MaxInt64 {
+			return nil, false
+		}
+		return &IntegerLiteral{IntegerValue: int64(val), Span: span}, true
+	default:
+		return nil, false
+	}
+}
+
+func coerceFloat64(v Valuer, span Span) (Valuer, bool) {
+	if s, ok := stringLiteral(v); ok {
+		n, err := strconv.ParseFloat(s, 64)
+		return &NumberLiteral{NumberValue: n, Span: span}, err == nil
+	}
+
+	switch val := v.Value().(type) {
+	case float64:
+		return v, true
+	case int64:
+		return &NumberLiteral{NumberValue: float64(val), Span: span}, true
+	default:
+		return nil, false
+	}
+}
+
+func coerceBool(v Valuer, span Span) (Valuer, bool) {
+	if s, ok := stringLiteral(v); ok {
+		b, err := strconv.ParseBool(s)
+		return &BooleanLiteral{BooleanValue: b, Span: span}, err == nil
+	}
+
+	_, ok := v.Value().(bool)
+
+	return v, ok
+}
+
+func coerceTime(v Valuer, span Span) (Valuer, bool) {
+	if s, ok := stringLiteral(v); ok {
+		t, err := parseTimeValue(s)
+		return &TimeLiteral{TimeValue: t, Span: span}, err == nil
+	}
+
+	_, ok := v.Value().(time.Time)
+
+	return v, ok
+}
+
+func coerceDuration(v Valuer, span Span) (Valuer, bool) {
+	if s, ok := stringLiteral(v); ok {
+		d, err := parseDurationValue(s)
+		return &DurationLiteral{DurationValue: d, Span: span}, err == nil
+	}
+
+	_, ok := v.Value().(time.Duration)
+
+	return v, ok
+}
@@ -0,0 +1,288 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/defer-panic/dumbql/match"
+	"github.com/defer-panic/dumbql/query"
+	"github.com/defer-panic/dumbql/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFieldExpr_Validate_Coercion(t *testing.T) { //nolint:funlen
+	fields := schema.Fields{
+		"price":      {Rule: schema.Max[float64](100), Type: schema.TypeFloat64},
+		"count":      {Type: schema.TypeInt64},
+		"active":     {Type: schema.TypeBool},
+		"created_at": {Type: schema.TypeTime},
+		"timeout":    {Type: schema.TypeDuration},
+		"code":       {Rule: schema.EqualsOneOf("200", "404"), Type: schema.TypeString},
+		"sku":        {Type: schema.TypeString},
+		"any":        {},
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		wantSQL  string
+		wantArgs []any
+		wantErr  string
+	}{
+		{
+			name:     "integer to float",
+			input:    `price:10`,
+			wantSQL:  "price = ?",
+			wantArgs: []any{float64(10)},
+		},
+		{
+			name:     "string to float",
+			input:    `price:"9.5"`,
+			wantSQL:  "price = ?",
+			wantArgs: []any{9.5},
+		},
+		{
+			name:     "integral float to integer",
+			input:    `count >= 2.0`,
+			wantSQL:  "count >= ?",
+			wantArgs: []any{int64(2)},
+		},
+		{
+			name:     "string to boolean",
+			input:    `active:"true"`,
+			wantSQL:  "active = ?",
+			wantArgs: []any{true},
+		},
+		{
+			name:     "string to date",
+			input:    `created_at > "2024-01-01"`,
+			wantSQL:  "created_at > ?",
+			wantArgs: []any{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:     "string to duration",
+			input:    `timeout < "1h30m"`,
+			wantSQL:  "timeout < ?",
+			wantArgs: []any{90 * time.Minute},
+		},
+		{
+			name:     "integer to string",
+			input:    `code:200`,
+			wantSQL:  "code = ?",
+			wantArgs: []any{"200"},
+		},
+		{
+			name:     "number to string keeps text",
+			input:    `sku:1.50`,
+			wantSQL:  "sku = ?",
+			wantArgs: []any{"1.50"},
+		},
+		{
+			name:     "boolean to string keeps text",
+			input:    `sku:TRUE`,
+			wantSQL:  "sku = ?",
+			wantArgs: []any{"TRUE"},
+		},
+		{
+			name:     "date to string",
+			input:    `sku:2024-01-01`,
+			wantSQL:  "sku = ?",
+			wantArgs: []any{"2024-01-01"},
+		},
+		{
+			name:     "date time to string",
+			input:    `sku:2024-01-01t10:00:00z`,
+			wantSQL:  "sku = ?",
+			wantArgs: []any{"2024-01-01t10:00:00z"},
+		},
+		{
+			name:     "relative time to string",
+			input:    `sku:now and sku != now-1d`,
+			wantSQL:  "(sku = ? AND sku <> ?)",
+			wantArgs: []any{"now", "now-1d"},
+		},
+		{
+			name:     "duration to string",
+			input:    `sku:1h`,
+			wantSQL:  "sku = ?",
+			wantArgs: []any{"1h"},
+		},
+		{
+			name:     "one of to string",
+			input:    `sku:[now, later, 1h, 2024-01-01, 1.50]`,
+			wantSQL:  "sku IN (?,?,?,?,?)",
+			wantArgs: []any{"now", "later", "1h", "2024-01-01", "1.50"},
+		},
+		{
+			name:     "one of",
+			input:    `price:[1, 2.5]`,
+			wantSQL:  "price IN (?,?)",
+			wantArgs: []any{float64(1), 2.5},
+		},
+		{
+			name:     "range",
+			input:    `price:[1 TO 2]`,
+			wantSQL:  "price BETWEEN ? AND ?",
+			wantArgs: []any{float64(1), float64(2)},
+		},
+		{
+			name:     "null is kept",
+			input:    `count:null`,
+			wantSQL:  "count IS NULL",
+			wantArgs: nil,
+		},
+		{
+			name:     "untyped field",
+			input:    `any:10`,
+			wantSQL:  "any = ?",
+			wantArgs: []any{int64(10)},
+		},
+		{
+			name:    "rule checks coerced value",
+			input:   `price:101`,
+			wantErr: `field "price": value must be equal or less than 100, got 101`,
+		},
+		{
+			name:    "fractional float to integer",
+			input:   `count:2.5`,
+			wantErr: `field "count": value must be int64, got float64`,
+		},
+		{
+			name:    "invalid string",
+			input:   `price:cheap`,
+			wantErr: `field "price": value must be float64, got string`,
+		},
+		{
+			name:    "regex is not a number",
+			input:   `count:/42/`,
+			wantErr: `field "count": value must be int64, got string`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ast, err := query.Parse("test", []byte(test.input))
+			require.NoError(t, err)
+
+			got, err := ast.(query.Expr).Validate(fields)
+			if test.wantErr != "" {
+				require.EqualError(t, err, test.wantErr)
+				require.Nil(t, got)
+
+				errs := schema.ValidationErrors(err)
+				require.Len(t, errs, 1)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			sql, args, err := sq.Select("*").From("t").Where(got).ToSql()
+			require.NoError(t, err)
+			assert.Equal(t, "SELECT * FROM t WHERE "+test.wantSQL, sql)
+			assert.Equal(t, test.wantArgs, args)
+		})
+	}
+}
+
+func TestFieldExpr_Validate_RuleType(t *testing.T) {
+	schm := schema.Schema{
+		"price":   schema.Max[float64](100),
+		"code":    schema.Is[string](),
+		"timeout": schema.All(schema.Is[time.Duration](), schema.Min(time.Second)),
+		"age":     schema.InRange[int64](0, 150),
+		"custom":  func(schema.Field, any) error { return nil },
+		"ratio": func(field schema.Field, value any) error {
+			if _, ok := value.(float64); !ok {
+				return &schema.ValidationError{Field: field, Code: schema.CodeType, Expected: schema.TypeFloat64}
+			}
+			return nil
+		},
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		wantSQL  string
+		wantArgs []any
+		wantErr  string
+	}{
+		{name: "integer to float", input: `price:10`, wantSQL: "price = ?", wantArgs: []any{float64(10)}},
+		{name: "rule is checked", input: `price > 200`, wantErr: `field "price": value must be equal or less than 100`},
+		{name: "range", input: `price:[1 TO 9.5]`, wantSQL: "price BETWEEN ? AND ?", wantArgs: []any{float64(1), 9.5}},
+		{name: "one of", input: `price:[1, 2.5]`, wantSQL: "price IN (?,?)", wantArgs: []any{float64(1), 2.5}},
+		{name: "integer to string", input: `code:200`, wantSQL: "code = ?", wantArgs: []any{"200"}},
+		{name: "string to duration", input: `timeout:"5s"`, wantSQL: "timeout = ?", wantArgs: []any{5 * time.Second}},
+		{name: "not convertible", input: `price:abc`, wantErr: `field "price": value must be float64, got string`},
+		{name: "string to integer", input: `age:"30"`, wantSQL: "age = ?", wantArgs: []any{int64(30)}},
+		{name: "untyped rule", input: `custom:10`, wantSQL: "custom = ?", wantArgs: []any{int64(10)}},
+		{name: "custom typed rule", input: `ratio:1`, wantSQL: "ratio = ?", wantArgs: []any{float64(1)}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ast, err := query.Parse("test", []byte(test.input))
+			require.NoError(t, err)
+
+			got, err := ast.(query.Expr).Validate(schm)
+			if test.wantErr != "" {
+				require.ErrorContains(t, err, test.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+
+			sql, args, err := sq.Select("*").From("t").Where(got).ToSql()
+			require.NoError(t, err)
+			assert.Equal(t, "SELECT * FROM t WHERE "+test.wantSQL, sql)
+			assert.Equal(t, test.wantArgs, args)
+		})
+	}
+}
+
+func TestFieldExpr_Validate_CoercionMatch(t *testing.T) {
+	type product struct {
+		Price float64 `dumbql:"price"`
+	}
+
+	fields := schema.Fields{
+		"price": {Type: schema.TypeFloat64},
+	}
+
+	ast, err := query.Parse("test", []byte(`price:10`))
+	require.NoError(t, err)
+
+	got, err := ast.(query.Expr).Validate(fields)
+	require.NoError(t, err)
+
+	fieldExpr, isFieldExpr := got.(*query.FieldExpr)
+	require.True(t, isFieldExpr)
+	assert.IsType(t, &query.NumberLiteral{}, fieldExpr.Value)
+
+	assert.True(t, got.Match(&product{Price: 10}, &match.StructMatcher{}))
+}
+
+func TestFieldExpr_Validate_CoercionManualLiteral(t *testing.T) {
+	fields := schema.Fields{"sku": {Type: schema.TypeString}}
+
+	tests := []struct {
+		value query.Valuer
+		want  string
+	}{
+		{value: &query.NumberLiteral{NumberValue: 1.5}, want: "1.5"},
+		{value: &query.DurationLiteral{DurationValue: 90 * time.Minute}, want: "1h30m"},
+		{value: &query.TimeLiteral{TimeValue: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}, want: "2024-01-01T00:00:00Z"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.want, func(t *testing.T) {
+			expr := &query.FieldExpr{Field: "sku", Op: query.Equal, Value: test.value}
+
+			got, err := expr.Validate(fields)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got.(*query.FieldExpr).Value.Value())
+		})
+	}
+}
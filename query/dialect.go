@@ -0,0 +1,517 @@
+package query
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/defer-panic/dumbql/schema"
+)
+
+// Dialect describes parts of SQL syntax which differ between database engines.
+type Dialect interface {
+	// Like returns predicate checking that the column matches LIKE pattern case-sensitively, like the struct matcher
+	// does. Backslash is used as escape character in the pattern.
+	Like(column, pattern string) sq.Sqlizer
+	// ILike is case-insensitive variant of Like.
+	ILike(column, pattern string) sq.Sqlizer
+	// Regexp returns predicate checking that the column matches regular expression.
+	Regexp(column, pattern string, caseInsensitive bool) sq.Sqlizer
+	// QuoteIdentifier converts the field name into column reference. Dotted names are split into parts quoted
+	// separately, e.g. `"u"."email"`. Error is returned for names which can't be used safely.
+	QuoteIdentifier(name string) (string, error)
+	// PlaceholderFormat returns format of query parameters, e.g. sq.Dollar for `$1`.
+	PlaceholderFormat() sq.PlaceholderFormat
+	// BoolValue returns query parameter for the boolean value, e.g. 1 or 0 for engines without boolean type.
+	BoolValue(value bool) any
+	// In returns predicate checking that the column is equal to any of the values, or to none of them if not is set.
+	// Name is the field name passed to QuoteIdentifier, and column is the column reference built from it.
+	In(name, column string, values []any, not bool) sq.Sqlizer
+	// Cast returns the column expression to compare with the value, e.g. with type cast. Like operators are not
+	// affected. Name is the field name passed to QuoteIdentifier, and column is the column reference built from it.
+	// Built-in dialects return the column as is, except PostgreSQLJSONB.
+	Cast(name, column string, value any) string
+}
+
+// Built-in dialects. Generic dialect is used by ToSql. It doesn't quote identifiers, so names which are not plain
+// identifiers (letters, digits and underscores, optionally separated by dots) are rejected.
+var (
+	Generic    Dialect = genericDialect{}
+	PostgreSQL Dialect = postgresDialect{}
+	MySQL      Dialect = mysqlDialect{}
+	SQLite     Dialect = sqliteDialect{}
+	ClickHouse Dialect = clickhouseDialect{}
+	SQLServer  Dialect = sqlserverDialect{}
+)
+
+// WithDialect binds the expression to the dialect, so it can be passed to squirrel query builder. Unlike
+// Expr.ToSqlDialect, the bound expression uses `?` placeholders, because the builder replaces them for the whole query,
+// so set its PlaceholderFormat to the one of the dialect.
+func WithDialect(expr Expr, dialect Dialect) sq.Sqlizer {
+	return dialectExpr{expr: expr, dialect: dialect}
+}
+
+type dialectExpr struct {
+	expr    Expr
+	dialect Dialect
+}
+
+func (e dialectExpr) ToSql() (string, []any, error) { //nolint:revive
+	return e.expr.ToSqlDialect(questionDialect{e.dialect})
+}
+
+// questionDialect keeps `?` placeholders, so they are replaced only once for the whole query.
+type questionDialect struct {
+	Dialect
+}
+
+func (questionDialect) PlaceholderFormat() sq.PlaceholderFormat {
+	return sq.Question
+}
+
+// replacePlaceholders formats `?` placeholders of the SQL according to the dialect.
+func replacePlaceholders(d Dialect, sql string, args []any, err error) (string, []any, error) {
+	if err != nil {
+		return "", nil, err
+	}
+
+	sql, err = d.PlaceholderFormat().ReplacePlaceholders(sql)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return sql, args, nil
+}
+
+// inList expands the values into `IN (?, ...)` list.
+func inList(column string, values []any, not bool) sq.Sqlizer {
+	if not {
+		return sq.NotEq{column: values}
+	}
+
+	return sq.Eq{column: values}
+}
+
+// standardDialect holds parts of SQL syntax shared by built-in dialects.
+type standardDialect struct{}
+
+func (standardDialect) PlaceholderFormat() sq.PlaceholderFormat {
+	return sq.Question
+}
+
+func (standardDialect) BoolValue(value bool) any {
+	return value
+}
+
+func (standardDialect) In(_, column string, values []any, not bool) sq.Sqlizer {
+	return inList(column, values, not)
+}
+
+func (standardDialect) Cast(_, column string, _ any) string {
+	return column
+}
+
+// numericBoolDialect binds booleans as 1 and 0 for engines without boolean type.
+type numericBoolDialect struct {
+	standardDialect
+}
+
+func (numericBoolDialect) BoolValue(value bool) any {
+	if value {
+		return int64(1)
+	}
+
+	return int64(0)
+}
+
+// RestrictFields returns the dialect which resolves every field through the mapper before quoting, so SQL is emitted
+// only for whitelisted fields, even if the expression wasn't validated or mapped with MapFields. Fields are passed to
+// the mapper as they are in the expression, e.g. use AllowFields to whitelist them without renaming.
+func RestrictFields(dialect Dialect, mapper FieldMapper) Dialect {
+	return restrictedDialect{Dialect: dialect, mapper: mapper}
+}
+
+type restrictedDialect struct {
+	Dialect
+	mapper FieldMapper
+}
+
+func (d restrictedDialect) QuoteIdentifier(name string) (string, error) {
+	column, ok := d.mapper.MapField(name)
+	if !ok {
+		return "", &schema.ValidationError{Field: schema.Field(name), Code: schema.CodeUnknownField}
+	}
+
+	return d.Dialect.QuoteIdentifier(column)
+}
+
+// Cast passes the mapped name to the dialect, like QuoteIdentifier does.
+func (d restrictedDialect) Cast(name, column string, value any) string {
+	mapped, _ := d.mapper.MapField(name)
+	return d.Dialect.Cast(mapped, column, value)
+}
+
+// In passes the mapped name to the dialect, like QuoteIdentifier does.
+func (d restrictedDialect) In(name, column string, values []any, not bool) sq.Sqlizer {
+	mapped, _ := d.mapper.MapField(name)
+	return d.Dialect.In(mapped, column, values, not)
+}
+
+var plainIdentifier = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*(\.[a-zA-Z_][a-zA-Z0-9_]*)*$`)
+
+// quoteIdentifier quotes every part of the dotted name, doubling the closing quote inside the part. Question marks
+// are rejected, because squirrel would take them for placeholders even inside quotes.
+func quoteIdentifier(name, left, right string) (string, error) {
+	parts := strings.Split(name, ".")
+
+	for i, part := range parts {
+		if part == "" || strings.Contains(part, "?") {
+			return "", fmt.Errorf("invalid identifier %q", name)
+		}
+
+		parts[i] = left + strings.ReplaceAll(part, right, right+right) + right
+	}
+
+	return strings.Join(parts, "."), nil
+}
+
+type genericDialect struct {
+	standardDialect
+}
+
+func (genericDialect) QuoteIdentifier(name string) (string, error) {
+	if !plainIdentifier.MatchString(name) {
+		return "", fmt.Errorf("invalid identifier %q", name)
+	}
+
+	return name, nil
+}
+
+func (genericDialect) Like(column, pattern string) sq.Sqlizer {
+	return sq.Expr(column+` LIKE ? ESCAPE '\'`, pattern)
+}
+
+func (genericDialect) ILike(column, pattern string) sq.Sqlizer {
+	return sq.Expr("LOWER("+column+`) LIKE LOWER(?) ESCAPE '\'`, pattern)
+}
+
+func (genericDialect) Regexp(column, pattern string, caseInsensitive bool) sq.Sqlizer {
+	return sq.Expr(column+" REGEXP ?", inlineRegexpFlags(pattern, caseInsensitive))
+}
+
+type postgresDialect struct {
+	standardDialect
+}
+
+func (postgresDialect) QuoteIdentifier(name string) (string, error) {
+	return quoteIdentifier(name, `"`, `"`)
+}
+
+func (postgresDialect) PlaceholderFormat() sq.PlaceholderFormat {
+	return sq.Dollar
+}
+
+func (postgresDialect) Like(column, pattern string) sq.Sqlizer {
+	return sq.Expr(column+" LIKE ?", pattern) // backslash is the default escape character
+}
+
+func (postgresDialect) ILike(column, pattern string) sq.Sqlizer {
+	return sq.Expr(column+" ILIKE ?", pattern)
+}
+
+func (postgresDialect) Regexp(column, pattern string, caseInsensitive bool) sq.Sqlizer {
+	if caseInsensitive {
+		return sq.Expr(column+" ~* ?", pattern)
+	}
+
+	return sq.Expr(column+" ~ ?", pattern)
+}
+
+// PostgreSQLJSONB returns PostgreSQL dialect which translates fields with schema.Descriptor.JSONColumn into paths in
+// the jsonb column, e.g. `profile.age` into `"data"->'profile'->>'age'`. Other fields, including the ones not defined
+// in the schema, are regular columns. The path is cast by the type of the compared value: `::numeric` for numbers,
+// `::boolean` for booleans and `::timestamptz` for dates, strings are compared as text. One-of is converted into
+// `= ANY(?)` (`<> ALL(?)` for none of) with values passed as a single typed slice, e.g. []string or []float64, so
+// values of one-of must have the same type.
+func PostgreSQLJSONB(schm schema.Definition) Dialect {
+	return jsonbDialect{schema: schm}
+}
+
+type jsonbDialect struct {
+	postgresDialect
+	schema schema.Definition
+}
+
+// jsonColumn returns the JSON column holding the field, or empty string for regular columns.
+func (d jsonbDialect) jsonColumn(name string) string {
+	desc, _ := d.schema.Describe(schema.Field(name))
+	return desc.JSONColumn
+}
+
+func (d jsonbDialect) QuoteIdentifier(name string) (string, error) {
+	column := d.jsonColumn(name)
+	if column == "" {
+		return d.postgresDialect.QuoteIdentifier(name)
+	}
+
+	path, err := d.postgresDialect.QuoteIdentifier(column)
+	if err != nil {
+		return "", err
+	}
+
+	keys := strings.Split(name, ".")
+
+	for i, key := range keys {
+		if key == "" || strings.Contains(key, "?") {
+			return "", fmt.Errorf("invalid identifier %q", name)
+		}
+
+		op := "->"
+		if i == len(keys)-1 {
+			op = "->>" // the last key is extracted as text
+		}
+
+		path += op + "'" + strings.ReplaceAll(key, "'", "''") + "'"
+	}
+
+	return path, nil
+}
+
+func (d jsonbDialect) Cast(name, column string, value any) string {
+	if d.jsonColumn(name) == "" {
+		return column
+	}
+
+	switch value.(type) {
+	case int64, float64:
+		return "(" + column + ")::numeric"
+	case bool:
+		return "(" + column + ")::boolean"
+	case time.Time:
+		return "(" + column + ")::timestamptz"
+	default:
+		return column
+	}
+}
+
+func (d jsonbDialect) In(name, column string, values []any, not bool) sq.Sqlizer {
+	if d.jsonColumn(name) == "" || len(values) == 0 {
+		return inList(column, values, not)
+	}
+
+	array, err := typedSlice(values)
+	if err != nil {
+		return errorSqlizer{err: err}
+	}
+
+	if not {
+		return sq.Expr(column+" <> ALL(?)", array)
+	}
+
+	return sq.Expr(column+" = ANY(?)", array)
+}
+
+// typedSlice converts the values into slice of their type, because drivers can't encode []any as array. Integers
+// mixed with floats give []float64.
+func typedSlice(values []any) (any, error) {
+	if res, ok := sliceOf[string](values); ok {
+		return res, nil
+	}
+	if res, ok := sliceOf[int64](values); ok {
+		return res, nil
+	}
+	if res, ok := sliceOf[bool](values); ok {
+		return res, nil
+	}
+	if res, ok := sliceOf[time.Time](values); ok {
+		return res, nil
+	}
+
+	floats := make([]float64, 0, len(values))
+	for _, v := range values {
+		switch v := v.(type) {
+		case int64:
+			floats = append(floats, float64(v))
+		case float64:
+			floats = append(floats, v)
+		default:
+			return nil, mixedTypesError(values)
+		}
+	}
+
+	return floats, nil
+}
+
+func mixedTypesError(values []any) error {
+	for _, v := range values[1:] {
+		if reflect.TypeOf(v) != reflect.TypeOf(values[0]) {
+			return fmt.Errorf("one-of values must have the same type, got %T and %T", values[0], v)
+		}
+	}
+
+	return fmt.Errorf("one-of values of type %T are not supported", values[0])
+}
+
+func sliceOf[T any](values []any) ([]T, bool) {
+	res := make([]T, 0, len(values))
+
+	for _, v := range values {
+		t, ok := v.(T)
+		if !ok {
+			return nil, false
+		}
+		res = append(res, t)
+	}
+
+	return res, true
+}
+
+// errorSqlizer reports the error when the predicate is converted to SQL.
+type errorSqlizer struct {
+	err error
+}
+
+func (e errorSqlizer) ToSql() (string, []any, error) { //nolint:revive
+	return "", nil, e.err
+}
+
+type mysqlDialect struct {
+	standardDialect
+}
+
+func (mysqlDialect) QuoteIdentifier(name string) (string, error) {
+	return quoteIdentifier(name, "`", "`")
+}
+
+// Like compares the pattern as binary string, because LIKE is case-insensitive with default collations. CAST is used
+// instead of deprecated BINARY operator.
+func (mysqlDialect) Like(column, pattern string) sq.Sqlizer {
+	return sq.Expr(column+" LIKE CAST(? AS BINARY)", pattern) // backslash is the default escape character
+}
+
+func (mysqlDialect) ILike(column, pattern string) sq.Sqlizer {
+	return sq.Expr("LOWER("+column+") LIKE LOWER(?)", pattern)
+}
+
+func (mysqlDialect) Regexp(column, pattern string, caseInsensitive bool) sq.Sqlizer {
+	if caseInsensitive {
+		return sq.Expr("REGEXP_LIKE("+column+", ?, 'i')", pattern)
+	}
+
+	return sq.Expr("REGEXP_LIKE("+column+", ?, 'c')", pattern)
+}
+
+type sqliteDialect struct {
+	numericBoolDialect
+}
+
+func (sqliteDialect) QuoteIdentifier(name string) (string, error) {
+	return quoteIdentifier(name, `"`, `"`)
+}
+
+// Like uses GLOB, because LIKE is case-insensitive in SQLite.
+func (sqliteDialect) Like(column, pattern string) sq.Sqlizer {
+	return sq.Expr(column+" GLOB ?", likeToGlob(pattern))
+}
+
+func (sqliteDialect) ILike(column, pattern string) sq.Sqlizer {
+	return sq.Expr("LOWER("+column+`) LIKE LOWER(?) ESCAPE '\'`, pattern)
+}
+
+func (sqliteDialect) Regexp(column, pattern string, caseInsensitive bool) sq.Sqlizer {
+	return sq.Expr(column+" REGEXP ?", inlineRegexpFlags(pattern, caseInsensitive))
+}
+
+type clickhouseDialect struct {
+	standardDialect
+}
+
+func (clickhouseDialect) QuoteIdentifier(name string) (string, error) {
+	return quoteIdentifier(name, "`", "`")
+}
+
+func (clickhouseDialect) Like(column, pattern string) sq.Sqlizer {
+	return sq.Expr(column+" LIKE ?", pattern) // backslash is the default escape character
+}
+
+func (clickhouseDialect) ILike(column, pattern string) sq.Sqlizer {
+	return sq.Expr(column+" ILIKE ?", pattern)
+}
+
+func (clickhouseDialect) Regexp(column, pattern string, caseInsensitive bool) sq.Sqlizer {
+	return sq.Expr("match("+column+", ?)", inlineRegexpFlags(pattern, caseInsensitive))
+}
+
+type sqlserverDialect struct {
+	numericBoolDialect
+}
+
+func (sqlserverDialect) QuoteIdentifier(name string) (string, error) {
+	return quoteIdentifier(name, "[", "]")
+}
+
+func (sqlserverDialect) PlaceholderFormat() sq.PlaceholderFormat {
+	return sq.AtP
+}
+
+// Like sets case-sensitive collation, because LIKE is case-insensitive with default collations.
+func (sqlserverDialect) Like(column, pattern string) sq.Sqlizer {
+	return sq.Expr(column+` COLLATE Latin1_General_CS_AS LIKE ? ESCAPE '\'`, pattern)
+}
+
+func (sqlserverDialect) ILike(column, pattern string) sq.Sqlizer {
+	return sq.Expr("LOWER("+column+`) LIKE LOWER(?) ESCAPE '\'`, pattern)
+}
+
+func (sqlserverDialect) Regexp(column, pattern string, caseInsensitive bool) sq.Sqlizer {
+	if caseInsensitive {
+		return sq.Expr("REGEXP_LIKE("+column+", ?, 'i')", pattern)
+	}
+
+	return sq.Expr("REGEXP_LIKE("+column+", ?, 'c')", pattern)
+}
+
+// likeToGlob converts LIKE pattern with backslash escapes into GLOB pattern: `%` becomes `*`, `_` becomes `?`, and
+// GLOB special characters are put into brackets, e.g. `[*]`.
+func likeToGlob(pattern string) string {
+	var (
+		sb      strings.Builder
+		escaped bool
+	)
+
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+			continue
+		case r == '%':
+			sb.WriteByte('*')
+			continue
+		case r == '_':
+			sb.WriteByte('?')
+			continue
+		}
+
+		if r == '*' || r == '?' || r == '[' {
+			sb.WriteString("[" + string(r) + "]")
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+
+	return sb.String()
+}
+
+// inlineRegexpFlags adds case-insensitive flag to the pattern for engines which don't have a separate operator for it.
+func inlineRegexpFlags(pattern string, caseInsensitive bool) string {
+	if caseInsensitive {
+		return "(?i)" + pattern
+	}
+
+	return pattern
+}
@@ -0,0 +1,313 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"go.uber.org/multierr"
+)
+
+// ParseError describes a syntax error in the query.
+type ParseError struct {
+	Offset   int      // Byte offset of the error in the input.
+	Line     int      // Line number, starting at 1.
+	Column   int      // Column number in runes, starting at 1.
+	Token    string   // Offending token, empty at the end of input.
+	Expected []string // Readable tokens expected at the position, empty if the input is well-formed but invalid.
+	Err      error    // Underlying error.
+}
+
+func (e *ParseError) Error() string {
+	if len(e.Expected) == 0 {
+		return fmt.Sprintf("%d:%d: %v", e.Line, e.Column, e.Err)
+	}
+
+	token := "end of input"
+	if e.Token != "" {
+		token = strconv.Quote(e.Token)
+	}
+
+	return fmt.Sprintf("%d:%d: unexpected %s, expected %s", e.Line, e.Column, token, strings.Join(e.Expected, ", "))
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Caret renders the input line containing the error with a caret under the error position:
+//
+//	status:200 garbage
+//	           ^
+func (e *ParseError) Caret(input string) string {
+	lines := strings.Split(input, "\n")
+	if e.Line < 1 || e.Line > len(lines) {
+		return input
+	}
+
+	line := strings.TrimSuffix(lines[e.Line-1], "\r")
+
+	var indent strings.Builder
+
+	for i, r := range []rune(line) {
+		if i >= e.Column-1 {
+			break
+		}
+
+		// Keep tabs, so the caret is aligned with the line.
+		if r == '\t' {
+			indent.WriteRune(r)
+		} else {
+			indent.WriteByte(' ')
+		}
+	}
+
+	return line + "\n" + indent.String() + "^"
+}
+
+// SpanError is a validation error with the span of the invalid node in the query.
+type SpanError struct {
+	Span
+	Err error
+}
+
+func (e *SpanError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *SpanError) Unwrap() error {
+	return e.Err
+}
+
+// withSpan wraps every error combined in err with SpanError.
+func withSpan(span Span, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	errs := multierr.Errors(err)
+	wrapped := make([]error, 0, len(errs))
+
+	for _, e := range errs {
+		wrapped = append(wrapped, &SpanError{Span: span, Err: e})
+	}
+
+	return multierr.Combine(wrapped...)
+}
+
+// ParseErrors is a list of syntax errors in the query.
+type ParseErrors []*ParseError
+
+func (e ParseErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+func (e ParseErrors) Unwrap() []error {
+	errs := make([]error, 0, len(e))
+	for _, err := range e {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+// NewParseErrors converts the error returned by Parse into ParseErrors. Input is the parsed query, it's used to find
+// the offending tokens. Errors of other kinds are returned as is.
+func NewParseErrors(input []byte, err error) error {
+	var list errList
+	if !errors.As(err, &list) {
+		return err
+	}
+
+	res := make(ParseErrors, 0, len(list))
+
+	for _, e := range list {
+		var pe *parserError
+		if !errors.As(e, &pe) {
+			return err
+		}
+
+		parseErr := &ParseError{
+			Offset:   pe.pos.offset,
+			Line:     pe.pos.line,
+			Column:   pe.pos.col,
+			Expected: readableExpected(pe.expected),
+			Err:      pe.Inner,
+		}
+
+		if len(parseErr.Expected) == 0 && len(pe.expected) > 0 {
+			// Only a wildcard can continue the token, e.g. `10.0.0.1`, so the whole token is not a valid value.
+			start := wordStart(input, pe.pos.offset)
+			parseErr.Column -= pe.pos.offset - start
+			parseErr.Offset = start
+			parseErr.Expected = []string{expectedValue}
+		}
+
+		parseErr.Token = tokenAt(input, parseErr.Offset)
+		res = append(res, parseErr)
+	}
+
+	return res
+}
+
+// Readable names of expected tokens, in the order they are listed in the error message.
+const (
+	expectedField        = "field"
+	expectedTerm         = "search term"
+	expectedOperator     = "operator"
+	expectedValue        = "value"
+	expectedNumber       = "number"
+	expectedDate         = "date"
+	expectedDuration     = "duration"
+	expectedDigit        = "digit"
+	expectedDurationUnit = "duration unit"
+	expectedEscape       = "escape character"
+	expectedEndOfInput   = "end of input"
+)
+
+var expectedOrder = []string{
+	`"("`, `")"`, `"["`, `","`, `"]"`, `"}"`, `"to"`, `"\""`, `"/"`, `"*"`, `"-"`, `":"`, `"not"`, `"and"`,
+	`"or"`, `"now"`, expectedField, expectedTerm, expectedOperator, expectedValue, expectedNumber, expectedDate,
+	expectedDuration, expectedDigit, expectedDurationUnit, expectedEscape, expectedEndOfInput,
+}
+
+// readableExpected converts expectations of the parser, which are grammar literals and character classes, into
+// readable token names. Characters continuing the current token, e.g. digits of a number, are listed only if nothing
+// else can follow the token. Characters of wildcards are never listed, so the list is empty if only they can follow
+// the token, i.e. the token is not a valid value.
+func readableExpected(expected []string) []string {
+	has := make(map[string]bool, len(expected))
+	for _, e := range expected {
+		has[strings.ToLower(e)] = true
+	}
+
+	ctx := expectedContext{
+		// Only values include `now`, only values outside of ranges include strings, and only the start of
+		// an expression includes `not`.
+		value: has[`"now"`],
+		text:  has[`"\""`],
+		expr:  has[`"not"`],
+	}
+
+	var (
+		seen       = make(map[string]bool)
+		res        []string
+		continuing []string
+	)
+
+	for _, e := range expected {
+		for _, name := range ctx.readable(strings.ToLower(e)) {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			if name == expectedDigit || name == expectedDurationUnit {
+				continuing = append(continuing, name)
+			} else {
+				res = append(res, name)
+			}
+		}
+	}
+
+	if len(res) == 0 {
+		res = continuing
+	}
+
+	slices.SortStableFunc(res, func(a, b string) int {
+		return slices.Index(expectedOrder, a) - slices.Index(expectedOrder, b)
+	})
+
+	return res
+}
+
+// expectedContext tells what kind of token the parser expects, it's derived from the whole list of expectations.
+type expectedContext struct {
+	value bool // Any value, e.g. after operator.
+	text  bool // Value which can be a string, so any value and not a range bound.
+	expr  bool // Start of expression.
+}
+
+func (c expectedContext) readable(e string) []string { //nolint:cyclop
+	switch {
+	case e == "[ \\t\\r\\n]":
+		return nil
+	case e == "eof":
+		return []string{expectedEndOfInput}
+	case c.value && c.text:
+		return []string{expectedValue}
+	case c.value:
+		switch e {
+		case `"*"`, `"now"`:
+			return []string{e}
+		case `"-"`, `"0"`, "[0-9]", "[1-9]":
+			return []string{expectedNumber, expectedDate, expectedDuration}
+		default:
+			return nil
+		}
+	case c.expr && e == "[_a-za-z]":
+		return []string{expectedField}
+	case c.expr && (e == `"\""` || e == "[_a-za-z0-9]"):
+		return []string{expectedTerm}
+	}
+
+	switch e {
+	case `"and"`, `"or"`, `"not"`, `"("`, `")"`, `"["`, `","`, `"]"`, `"}"`, `"to"`, `"\""`, `"/"`, `"-"`, `":"`:
+		return []string{e}
+	case "[]}]":
+		return []string{`"]"`, `"}"`}
+	case `"!:"`, `"!="`, `"<"`, `"<="`, `">"`, `">="`, `"~"`, `"~*"`, "[:=]":
+		return []string{expectedOperator}
+	case `"0"`, "[0-9]", "[1-9]":
+		return []string{expectedDigit}
+	case `"ns"`, `"us"`, `"ms"`, "[smhdw]":
+		return []string{expectedDurationUnit}
+	case `"u"`, `["\\/bfnrt]`:
+		return []string{expectedEscape}
+	default:
+		return nil
+	}
+}
+
+// wordStart returns the offset of the first character of the bare word, which may be a wildcard, ending at the offset.
+func wordStart(input []byte, offset int) int {
+	start := min(offset, len(input))
+	for start > 0 && isWordChar(input[start-1]) {
+		start--
+	}
+
+	return start
+}
+
+func isWordChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || strings.IndexByte("_.-*?", c) >= 0
+}
+
+// tokenAt returns the sequence of non-space characters starting at the offset.
+func tokenAt(input []byte, offset int) string {
+	if offset < 0 || offset >= len(input) {
+		return ""
+	}
+
+	rest := input[offset:]
+
+	end := 0
+	for end < len(rest) {
+		r, size := utf8.DecodeRune(rest[end:])
+		if unicode.IsSpace(r) {
+			break
+		}
+		end += size
+	}
+
+	return string(rest[:end])
+}
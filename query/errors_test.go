@@ -0,0 +1,141 @@
+package query_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/defer-panic/dumbql/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewParseErrors(t *testing.T) { //nolint:funlen
+	tests := []struct {
+		name         string
+		input        string
+		wantOffset   int
+		wantLine     int
+		wantColumn   int
+		wantToken    string
+		wantExpected []string
+		wantCaret    string
+	}{
+		{
+			name:         "trailing input",
+			input:        "status:200 garbage",
+			wantOffset:   11,
+			wantLine:     1,
+			wantColumn:   12,
+			wantToken:    "garbage",
+			wantExpected: []string{`"and"`, `"or"`, "end of input"},
+			wantCaret:    "status:200 garbage\n           ^",
+		},
+		{
+			name:         "unexpected end of input",
+			input:        "(status:200",
+			wantOffset:   11,
+			wantLine:     1,
+			wantColumn:   12,
+			wantToken:    "",
+			wantExpected: []string{`")"`, `"and"`, `"or"`},
+			wantCaret:    "(status:200\n           ^",
+		},
+		{
+			name:         "multiline input",
+			input:        "status:200 and\n\tname:>x",
+			wantOffset:   21,
+			wantLine:     2,
+			wantColumn:   7,
+			wantToken:    ">x",
+			wantExpected: []string{"value"},
+			wantCaret:    "\tname:>x\n\t     ^",
+		},
+		{
+			name:       "invalid literal",
+			input:      "created_at > 2024-13-01",
+			wantOffset: 13,
+			wantLine:   1,
+			wantColumn: 14,
+			wantToken:  "2024-13-01",
+			wantCaret:  "created_at > 2024-13-01\n             ^",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := query.Parse("test", []byte(test.input))
+			require.Error(t, err)
+
+			err = query.NewParseErrors([]byte(test.input), err)
+
+			var parseErrs query.ParseErrors
+			require.ErrorAs(t, err, &parseErrs)
+			require.Len(t, parseErrs, 1)
+
+			var parseErr *query.ParseError
+			require.ErrorAs(t, err, &parseErr)
+			require.Same(t, parseErrs[0], parseErr)
+
+			assert.Equal(t, test.wantOffset, parseErr.Offset)
+			assert.Equal(t, test.wantLine, parseErr.Line)
+			assert.Equal(t, test.wantColumn, parseErr.Column)
+			assert.Equal(t, test.wantToken, parseErr.Token)
+			assert.Equal(t, test.wantExpected, parseErr.Expected)
+			assert.Equal(t, test.wantCaret, parseErr.Caret(test.input))
+
+			if len(test.wantExpected) == 0 {
+				assert.Error(t, errors.Unwrap(parseErr))
+			}
+		})
+	}
+}
+
+func TestNewParseErrors_Error(t *testing.T) {
+	input := []byte("status:200 garbage")
+
+	_, err := query.Parse("test", input)
+	require.Error(t, err)
+
+	err = query.NewParseErrors(input, err)
+	require.EqualError(t, err, `1:12: unexpected "garbage", expected "and", "or", end of input`)
+}
+
+func TestNewParseErrors_Readable(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "a:1 and", want: `1:8: unexpected end of input, expected "(", "not", field, search term`},
+		{input: "a:1 or or", want: `1:10: unexpected end of input, expected operator`},
+		{input: "age > ", want: `1:7: unexpected end of input, expected value`},
+		{input: "status in", want: `1:10: unexpected end of input, expected "["`},
+		{input: `name:"john`, want: `1:11: unexpected end of input, expected "\""`},
+		{input: "ip:10.0.0.1", want: `1:4: unexpected "10.0.0.1", expected value`},
+		{input: "a:1e5", want: `1:3: unexpected "1e5", expected value`},
+		{input: "a:1.", want: `1:5: unexpected end of input, expected digit`},
+		{input: "a:2024-01-", want: `1:11: unexpected end of input, expected digit`},
+		{input: "a > 1h3", want: `1:8: unexpected end of input, expected digit, duration unit`},
+		{input: "a > now-", want: `1:9: unexpected end of input, expected digit`},
+		{input: "a:[1 TO 2", want: `1:10: unexpected end of input, expected "]", "}"`},
+		{input: "a:[1 TO", want: `1:8: unexpected end of input, expected "*", "now", number, date, duration`},
+		{input: "a:/x", want: `1:5: unexpected end of input, expected "/"`},
+		{input: `a:"\q"`, want: `1:5: unexpected "q\"", expected escape character`},
+		{input: "(a:1", want: `1:5: unexpected end of input, expected ")", "and", "or"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			_, err := query.Parse("test", []byte(test.input))
+			require.Error(t, err)
+
+			err = query.NewParseErrors([]byte(test.input), err)
+			require.EqualError(t, err, test.want)
+			assert.NotContains(t, err.Error(), "no match found")
+		})
+	}
+}
+
+func TestNewParseErrors_OtherError(t *testing.T) {
+	err := errors.New("some error")
+	require.Same(t, err, query.NewParseErrors(nil, err))
+}
@@ -0,0 +1,377 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	identifierRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*(\.[a-zA-Z_][a-zA-Z0-9_]*)*$`)
+	termWordRegexp   = regexp.MustCompile(`^[a-zA-Z0-9_]+([.-][a-zA-Z0-9_]+)*$`)
+	wildcardRegexp   = regexp.MustCompile(`^[a-zA-Z0-9_.-]*[*?][a-zA-Z0-9_.*?-]*$`)
+)
+
+// Format returns the expression in DumbQL syntax. The result is canonical: keywords are lowercase, equality is written
+// as `field:value`, strings are quoted only when they can't be written bare, and parentheses are added only where
+// precedence requires them. Parsing the result gives the same expression, except for spans:
+//
+//   - fields of expanded free text terms are omitted, validation expands the term again
+//   - case-insensitive strings are written as usual strings, validation marks them again
+//   - LIKE patterns are written as strings and must be parsed with RawLikePatterns option
+//   - dotted ranges (18..65) are written in brackets ([18 TO 65])
+//   - numbers, booleans, dates and durations are written in canonical form, which is kept as text when the value is
+//     converted to string during validation, e.g. `code:1.50` gives "1.5" after formatting
+//
+// Error is returned if the expression can't be written in the syntax, e.g. the field name is not an identifier or
+// a value is not allowed in its position, like a regular expression inside one-of.
+func Format(expr Expr) (string, error) {
+	return (&formatter{}).format(expr)
+}
+
+// FormatIndent is like Format but puts every boolean operator on a new line, and the content of parentheses is
+// indented with the indent string. It's meant for long saved filters:
+//
+//	status:open
+//	and (
+//		priority > 2
+//		or assignee:*
+//	)
+func FormatIndent(expr Expr, indent string) (string, error) {
+	return (&formatter{indent: indent, pretty: true}).format(expr)
+}
+
+type formatter struct {
+	sb     strings.Builder
+	indent string
+	pretty bool
+}
+
+func (f *formatter) format(expr Expr) (string, error) {
+	if err := f.expr(expr, 0); err != nil {
+		return "", err
+	}
+
+	return f.sb.String(), nil
+}
+
+func (f *formatter) expr(expr Expr, depth int) error {
+	switch e := expr.(type) {
+	case *BinaryExpr:
+		return f.binary(e, depth)
+	case *NotExpr:
+		f.sb.WriteString("not ")
+		_, nested := e.Expr.(*BinaryExpr)
+		_, double := e.Expr.(*NotExpr)
+
+		return f.operand(e.Expr, nested || double, depth)
+	case *FieldExpr:
+		return f.field(e)
+	case *TermExpr:
+		// Term is re-parsed as a word only if it doesn't look like a keyword.
+		if termWordRegexp.MatchString(e.Term) && !isKeyword(e.Term) {
+			f.sb.WriteString(e.Term)
+		} else {
+			f.sb.WriteString(quoteString(e.Term))
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("format: unsupported expression %T", expr)
+	}
+}
+
+// binary writes the operands with parentheses where precedence requires them: `not` binds tighter than `and`,
+// and `and` binds tighter than `or`. Both operators are left-associative, so the right operand of the same operator
+// is parenthesized.
+func (f *formatter) binary(b *BinaryExpr, depth int) error {
+	if b.Op != And && b.Op != Or {
+		return fmt.Errorf("format: unknown boolean operator %q", b.Op)
+	}
+
+	if err := f.operand(b.Left, needsParens(b.Left, b.Op, false), depth); err != nil {
+		return err
+	}
+
+	if f.pretty {
+		f.newline(depth)
+	} else {
+		f.sb.WriteByte(' ')
+	}
+
+	f.sb.WriteString(b.Op.String())
+	f.sb.WriteByte(' ')
+
+	return f.operand(b.Right, needsParens(b.Right, b.Op, true), depth)
+}
+
+func needsParens(operand Expr, op BooleanOperator, right bool) bool {
+	b, ok := operand.(*BinaryExpr)
+	if !ok {
+		return false
+	}
+
+	return (b.Op == Or && op == And) || (right && b.Op == op)
+}
+
+func (f *formatter) operand(expr Expr, parens bool, depth int) error {
+	if !parens {
+		return f.expr(expr, depth)
+	}
+
+	f.sb.WriteByte('(')
+	if f.pretty {
+		f.newline(depth + 1)
+	}
+
+	if err := f.expr(expr, depth+1); err != nil {
+		return err
+	}
+
+	if f.pretty {
+		f.newline(depth)
+	}
+	f.sb.WriteByte(')')
+
+	return nil
+}
+
+func (f *formatter) newline(depth int) {
+	f.sb.WriteByte('\n')
+	f.sb.WriteString(strings.Repeat(f.indent, depth))
+}
+
+func (f *formatter) field(e *FieldExpr) error {
+	field := e.Field.String()
+	if !identifierRegexp.MatchString(field) {
+		return fmt.Errorf("format: invalid field name %q", field)
+	}
+
+	f.sb.WriteString(field)
+
+	switch e.Op {
+	case Exists:
+		f.sb.WriteString(":*")
+		return nil
+	case Equal:
+		// `field:*` is parsed as the existence check.
+		if w, ok := e.Value.(*WildcardLiteral); ok && w.Pattern == "*" {
+			return fmt.Errorf("format: field %q: wildcard %q can't be used with %q", field, w.Pattern, e.Op)
+		}
+		f.sb.WriteByte(':')
+	case NotEqual, GreaterThan, GreaterThanOrEqual, LessThan, LessThanOrEqual, Like, ILike:
+		f.sb.WriteString(" " + e.Op.String() + " ")
+	default:
+		return fmt.Errorf("format: field %q: unknown operator %q", field, e.Op)
+	}
+
+	value, err := formatValue(e.Value, inField)
+	if err != nil {
+		return fmt.Errorf("format: field %q: %w", field, err)
+	}
+
+	f.sb.WriteString(value)
+
+	return nil
+}
+
+// valueContext is a place in the query where the value is written. Each of them allows different kinds of values.
+type valueContext uint8
+
+const (
+	inField valueContext = iota
+	inOneOf
+	inRange
+)
+
+func formatValue(v Valuer, ctx valueContext) (string, error) { //nolint:cyclop
+	switch v := v.(type) {
+	case *StringLiteral:
+		return formatString(v.StringValue), nil
+	case Identifier:
+		return formatString(string(v)), nil
+	case *LikePatternLiteral:
+		return formatString(v.Pattern), nil
+	case *IntegerLiteral:
+		return strconv.FormatInt(v.IntegerValue, 10), nil
+	case *NumberLiteral:
+		return formatNumber(v.NumberValue)
+	case *BooleanLiteral:
+		return strconv.FormatBool(v.BooleanValue), nil
+	case *TimeLiteral:
+		return formatTime(v.TimeValue)
+	case *RelativeTimeLiteral, *DurationLiteral:
+		return fmt.Sprint(v), nil
+	}
+
+	if ctx == inOneOf {
+		return "", fmt.Errorf("%T is not allowed in one-of", v)
+	}
+
+	switch v := v.(type) {
+	case *OneOfExpr:
+		return formatOneOf(v)
+	case *RangeExpr:
+		return formatRange(v)
+	case *NullLiteral:
+		return "null", nil
+	case *WildcardLiteral:
+		if !wildcardRegexp.MatchString(v.Pattern) {
+			return "", fmt.Errorf("invalid wildcard %q", v.Pattern)
+		}
+		return v.Pattern, nil
+	case *RegexLiteral:
+		if strings.Contains(v.Pattern, "\n") {
+			return "", fmt.Errorf("invalid regular expression %q: newlines are not allowed", v.Pattern)
+		}
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("unsupported value %T", v)
+	}
+}
+
+func formatOneOf(o *OneOfExpr) (string, error) {
+	values := make([]string, 0, len(o.Values))
+
+	for _, v := range o.Values {
+		s, err := formatValue(v, inOneOf)
+		if err != nil {
+			return "", err
+		}
+		values = append(values, s)
+	}
+
+	return "[" + strings.Join(values, ", ") + "]", nil
+}
+
+func formatRange(r *RangeExpr) (string, error) {
+	if r.Low == nil && r.High == nil {
+		return "", errors.New("range must have at least one bound")
+	}
+
+	left, right := "[", "]"
+	if r.LowExclusive {
+		left = "{"
+	}
+	if r.HighExclusive {
+		right = "}"
+	}
+
+	low, err := formatRangeBound(r.Low)
+	if err != nil {
+		return "", err
+	}
+
+	high, err := formatRangeBound(r.High)
+	if err != nil {
+		return "", err
+	}
+
+	return left + low + " TO " + high + right, nil
+}
+
+func formatRangeBound(v Valuer) (string, error) {
+	switch v.(type) {
+	case nil:
+		return "*", nil
+	case *IntegerLiteral, *NumberLiteral, *TimeLiteral, *RelativeTimeLiteral, *DurationLiteral:
+		return formatValue(v, inRange)
+	default:
+		return "", fmt.Errorf("%T is not allowed in range", v)
+	}
+}
+
+// formatNumber formats the float with the shortest representation which parses back to the same value. The decimal
+// point is always present, otherwise the number would be parsed as an integer.
+func formatNumber(n float64) (string, error) {
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return "", fmt.Errorf("invalid number %v", n)
+	}
+
+	s := strconv.FormatFloat(n, 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+
+	return s, nil
+}
+
+// formatTime formats midnight UTC as date and other moments as RFC3339 date and time.
+func formatTime(t time.Time) (string, error) {
+	if t.Year() < 0 || t.Year() > 9999 {
+		return "", fmt.Errorf("invalid date %s: year is out of range", t)
+	}
+
+	if t.Location() == time.UTC && t.Equal(t.Truncate(24*time.Hour)) {
+		return t.Format(time.DateOnly), nil
+	}
+
+	return t.Format(time.RFC3339Nano), nil
+}
+
+// formatString writes the string bare if it's parsed back as the same string, and quoted otherwise.
+func formatString(s string) string {
+	if identifierRegexp.MatchString(s) && !isReservedWord(s) {
+		return s
+	}
+
+	return quoteString(s)
+}
+
+// isReservedWord reports whether the bare word is parsed as something else than a string.
+func isReservedWord(s string) bool {
+	switch s {
+	case "true", "TRUE", "false", "FALSE", "null", "NULL", "now":
+		return true
+	default:
+		return isKeyword(s)
+	}
+}
+
+func isKeyword(s string) bool {
+	switch s {
+	case "and", "AND", "or", "OR", "not", "NOT":
+		return true
+	default:
+		return false
+	}
+}
+
+// quoteString quotes the string using only escape sequences supported by the grammar.
+func quoteString(s string) string {
+	var sb strings.Builder
+
+	sb.WriteByte('"')
+
+	for _, r := range s {
+		switch r {
+		case '"', '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case '\b':
+			sb.WriteString(`\b`)
+		case '\f':
+			sb.WriteString(`\f`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		default:
+			if r < 0x20 { //nolint:mnd
+				fmt.Fprintf(&sb, `\u%04x`, r)
+				continue
+			}
+			sb.WriteRune(r)
+		}
+	}
+
+	sb.WriteByte('"')
+
+	return sb.String()
+}
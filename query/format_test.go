@@ -0,0 +1,217 @@
+package query_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/defer-panic/dumbql/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat(t *testing.T) { //nolint:funlen
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "equal", input: `status = 200`, want: `status:200`},
+		{name: "comparison", input: `age>=18`, want: `age >= 18`},
+		{name: "not equal", input: `status !: closed`, want: `status != closed`},
+		{name: "float", input: `eps<0.003`, want: `eps < 0.003`},
+		{name: "float precision", input: `eps<0.1234567890123`, want: `eps < 0.1234567890123`},
+		{name: "integral float", input: `price:2.0`, want: `price:2.0`},
+		{name: "negative", input: `balance < -12.5`, want: `balance < -12.5`},
+		{name: "bare string", input: `name:"john"`, want: `name:john`},
+		{name: "quoted string", input: `name:"John Doe"`, want: `name:"John Doe"`},
+		{name: "escapes", input: `name:"a\"b\\c\n\u0001"`, want: `name:"a\"b\\c\n\u0001"`},
+		{name: "reserved words", input: `a:"true" and b:"null" and c:"now" and d:"and"`,
+			want: `a:"true" and b:"null" and c:"now" and d:"and"`},
+		{name: "boolean and null", input: `active:TRUE and deleted_at = NULL`, want: `active:true and deleted_at:null`},
+		{name: "exists", input: `email = *`, want: `email:*`},
+		{name: "like", input: `name ~* "jo"`, want: `name ~* jo`},
+		{name: "one of", input: `status IN [ open,"in progress" , 1 ]`, want: `status:[open, "in progress", 1]`},
+		{name: "not in", input: `status not in [open]`, want: `status != [open]`},
+		{name: "empty one of", input: `status:[]`, want: `status:[]`},
+		{name: "range", input: `age:18..65`, want: `age:[18 TO 65]`},
+		{name: "exclusive range", input: `age:{18 to *]`, want: `age:{18 TO *]`},
+		{name: "date", input: `created_at >= 2024-01-01T00:00:00Z`, want: `created_at >= 2024-01-01`},
+		{name: "date time", input: `created_at > 2024-01-01t10:00:00.5z`, want: `created_at > 2024-01-01T10:00:00.5Z`},
+		{name: "relative time", input: `created_at > now-1w`, want: `created_at > now-7d`},
+		{name: "duration", input: `ttl < 90m`, want: `ttl < 1h30m`},
+		{name: "wildcard", input: `name:Jo?n*`, want: `name:Jo?n*`},
+		{name: "regex", input: `path ~ /^a\/b$/i`, want: `path ~ /^a\/b$/i`},
+		{name: "term", input: `"broken pipe" or error`, want: `"broken pipe" or error`},
+		{name: "keyword term", input: `"and" or "Not"`, want: `"and" or Not`},
+		{name: "keywords", input: `a:1 AND NOT b:2 OR c:3`, want: `a:1 and not b:2 or c:3`},
+		{name: "redundant parentheses", input: `((a:1 and b:2)) or (c:3)`, want: `a:1 and b:2 or c:3`},
+		{name: "or inside and", input: `a:1 and (b:2 or c:3)`, want: `a:1 and (b:2 or c:3)`},
+		{name: "right associative", input: `a:1 or (b:2 or c:3)`, want: `a:1 or (b:2 or c:3)`},
+		{name: "not group", input: `not (a:1 and b:2)`, want: `not (a:1 and b:2)`},
+		{name: "double not", input: `not (not a:1)`, want: `not (not a:1)`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expr := parse(t, test.input, false)
+
+			got, err := query.Format(expr)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+
+			assert.Equal(t, expr, parse(t, got, false), "formatted query must be parsed to the same expression")
+		})
+	}
+}
+
+func TestFormat_RawLikePatterns(t *testing.T) {
+	expr := parse(t, `name ~ "J_hn%" or name ~* [Jo, "%son"]`, true)
+
+	got, err := query.Format(expr)
+	require.NoError(t, err)
+	assert.Equal(t, `name ~ "J_hn%" or name ~* [Jo, "%son"]`, got)
+	assert.Equal(t, expr, parse(t, got, true))
+}
+
+func TestFormatIndent(t *testing.T) {
+	expr := parse(t, `status:open and (priority > 2 or assignee:*) and not (a:1 and b:2)`, false)
+
+	got, err := query.FormatIndent(expr, "  ")
+	require.NoError(t, err)
+	assert.Equal(t, `status:open
+and (
+  priority > 2
+  or assignee:*
+)
+and not (
+  a:1
+  and b:2
+)`, got)
+
+	assert.Equal(t, expr, parse(t, got, false))
+}
+
+func TestFormat_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    query.Expr
+		wantErr string
+	}{
+		{
+			name:    "invalid field",
+			expr:    &query.FieldExpr{Field: "user name", Op: query.Equal, Value: &query.IntegerLiteral{IntegerValue: 1}},
+			wantErr: `format: invalid field name "user name"`,
+		},
+		{
+			name:    "infinity",
+			expr:    &query.FieldExpr{Field: "a", Op: query.Equal, Value: &query.NumberLiteral{NumberValue: math.Inf(1)}},
+			wantErr: `format: field "a": invalid number +Inf`,
+		},
+		{
+			name: "regex in one of",
+			expr: &query.FieldExpr{Field: "a", Op: query.Equal, Value: &query.OneOfExpr{
+				Values: []query.Valuer{&query.RegexLiteral{Pattern: "a"}},
+			}},
+			wantErr: `format: field "a": *query.RegexLiteral is not allowed in one-of`,
+		},
+		{
+			name:    "equal to any",
+			expr:    &query.FieldExpr{Field: "a", Op: query.Equal, Value: &query.WildcardLiteral{Pattern: "*"}},
+			wantErr: `format: field "a": wildcard "*" can't be used with "="`,
+		},
+		{
+			name:    "invalid wildcard",
+			expr:    &query.FieldExpr{Field: "a", Op: query.Equal, Value: &query.WildcardLiteral{Pattern: "a b*"}},
+			wantErr: `format: field "a": invalid wildcard "a b*"`,
+		},
+		{
+			name:    "unbounded range",
+			expr:    &query.FieldExpr{Field: "a", Op: query.Equal, Value: &query.RangeExpr{}},
+			wantErr: `format: field "a": range must have at least one bound`,
+		},
+		{
+			name: "nested error",
+			expr: &query.NotExpr{Expr: &query.BinaryExpr{
+				Left:  &query.TermExpr{Term: "x"},
+				Op:    query.And,
+				Right: &query.FieldExpr{Field: "1a", Op: query.Exists},
+			}},
+			wantErr: `format: invalid field name "1a"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := query.Format(test.expr)
+			assert.Empty(t, got)
+			assert.EqualError(t, err, test.wantErr)
+		})
+	}
+}
+
+func TestFormat_Time(t *testing.T) {
+	value := time.Date(2024, 1, 1, 10, 0, 0, 0, time.FixedZone("", 2*60*60))
+	expr := &query.FieldExpr{Field: "created_at", Op: query.LessThan, Value: &query.TimeLiteral{TimeValue: value}}
+
+	got, err := query.Format(expr)
+	require.NoError(t, err)
+	assert.Equal(t, `created_at < 2024-01-01T10:00:00+02:00`, got)
+
+	parsed := parse(t, got, false).(*query.FieldExpr)
+	assert.True(t, value.Equal(parsed.Value.(*query.TimeLiteral).TimeValue))
+}
+
+// parse parses the query and clears spans and unexported state of all nodes, e.g. source text of literals, so
+// expressions can be compared.
+func parse(t *testing.T, input string, rawLikePatterns bool) query.Expr {
+	t.Helper()
+
+	var opts []query.Option
+	if rawLikePatterns {
+		opts = append(opts, query.RawLikePatterns())
+	}
+
+	ast, err := query.Parse("test", []byte(input), opts...)
+	require.NoError(t, err)
+
+	expr := ast.(query.Expr)
+	clearSpans(reflect.ValueOf(expr))
+
+	return expr
+}
+
+func clearSpans(v reflect.Value) {
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			clearSpans(v.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			clearSpans(v.Index(i))
+		}
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(query.Span{}) {
+			v.Set(reflect.Zero(v.Type()))
+			return
+		}
+
+		if v.CanSet() && v.Type().PkgPath() == reflect.TypeOf(query.Span{}).PkgPath() {
+			exported := reflect.New(v.Type()).Elem()
+			for i := 0; i < v.NumField(); i++ {
+				if v.Type().Field(i).IsExported() {
+					exported.Field(i).Set(v.Field(i))
+				}
+			}
+			v.Set(exported)
+		}
+
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				clearSpans(v.Field(i))
+			}
+		}
+	}
+}
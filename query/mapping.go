@@ -0,0 +1,59 @@
+package query
+
+import "github.com/defer-panic/dumbql/schema"
+
+// FieldMapper maps field names of the query to column names.
+type FieldMapper interface {
+	// MapField returns the column name of the field and reports whether the field is mapped.
+	MapField(field string) (string, bool)
+}
+
+// FieldMap is a FieldMapper backed by a map of field names to column names. Several fields can be mapped to the same
+// column, e.g. both `mail` and `email` to `u.email_address`.
+type FieldMap map[string]string
+
+func (m FieldMap) MapField(field string) (string, bool) {
+	column, ok := m[field]
+	return column, ok
+}
+
+// AllowFields returns FieldMap which maps the fields to themselves, i.e. a whitelist of fields.
+func AllowFields(fields ...string) FieldMap {
+	m := make(FieldMap, len(fields))
+	for _, field := range fields {
+		m[field] = field
+	}
+
+	return m
+}
+
+// MapFields returns copy of the expression with fields renamed to columns by the mapper. Unmapped fields are reported
+// with schema.CodeUnknownField errors, and nil expression is returned, so field names from the query never reach SQL
+// as is. Terms must be expanded by validation before mapping, because their fields are mapped as well.
+func MapFields(expr Expr, mapper FieldMapper) (Expr, error) {
+	return Rewrite(expr, func(e Expr) (Expr, error) {
+		f, isField := e.(*FieldExpr)
+		if !isField {
+			return e, nil
+		}
+
+		mapped, err := f.mapField(mapper)
+		if err != nil {
+			return nil, err
+		}
+
+		return mapped, nil
+	})
+}
+
+func (f *FieldExpr) mapField(mapper FieldMapper) (*FieldExpr, error) {
+	column, ok := mapper.MapField(f.Field.String())
+	if !ok {
+		return nil, withSpan(f.Span, &schema.ValidationError{Field: schema.Field(f.Field), Code: schema.CodeUnknownField})
+	}
+
+	res := *f
+	res.Field = Identifier(column)
+
+	return &res, nil
+}
@@ -0,0 +1,111 @@
+package query_test
+
+import (
+	"testing"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/defer-panic/dumbql/query"
+	"github.com/defer-panic/dumbql/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapFields(t *testing.T) { //nolint:funlen
+	mapper := query.FieldMap{
+		"user.email": "u.email_address",
+		"email":      "u.email_address",
+		"mail":       "u.email_address",
+		"status":     "u.status",
+		"title":      "p.title",
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		wantSQL  string
+		wantArgs []any
+	}{
+		{
+			name:     "field",
+			input:    `user.email:"john@example.com"`,
+			wantSQL:  "u.email_address = ?",
+			wantArgs: []any{"john@example.com"},
+		},
+		{
+			name:     "aliases",
+			input:    `mail:a or email:b`,
+			wantSQL:  "(u.email_address = ? OR u.email_address = ?)",
+			wantArgs: []any{"a", "b"},
+		},
+		{
+			name:     "not and exists",
+			input:    `not status:closed and email:*`,
+			wantSQL:  "(NOT u.status = ? AND u.email_address IS NOT NULL)",
+			wantArgs: []any{"closed"},
+		},
+		{
+			name:     "one of",
+			input:    `status:[open, pending]`,
+			wantSQL:  "u.status IN (?,?)",
+			wantArgs: []any{"open", "pending"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ast, err := query.Parse("test", []byte(test.input))
+			require.NoError(t, err)
+
+			got, err := query.MapFields(ast.(query.Expr), mapper)
+			require.NoError(t, err)
+
+			sql, args, err := sq.Select("*").From("t").Where(got).ToSql()
+			require.NoError(t, err)
+			assert.Equal(t, "SELECT * FROM t WHERE "+test.wantSQL, sql)
+			assert.Equal(t, test.wantArgs, args)
+		})
+	}
+}
+
+func TestMapFields_Term(t *testing.T) {
+	fields := schema.Fields{
+		"title": {Searchable: true},
+		"body":  {Searchable: true},
+	}
+
+	ast, err := query.Parse("test", []byte(`broken`))
+	require.NoError(t, err)
+
+	validated, err := ast.(query.Expr).Validate(fields)
+	require.NoError(t, err)
+
+	got, err := query.MapFields(validated, query.FieldMap{"title": "p.title", "body": "p.body"})
+	require.NoError(t, err)
+
+	sql, args, err := got.ToSql()
+	require.NoError(t, err)
+	assert.Equal(t, `(p.body LIKE ? ESCAPE '\' OR p.title LIKE ? ESCAPE '\')`, sql)
+	assert.Equal(t, []any{"%broken%", "%broken%"}, args)
+
+	_, err = query.MapFields(validated, query.FieldMap{"title": "p.title"})
+	require.EqualError(t, err, `field "body" not found in schema`)
+}
+
+func TestMapFields_Unmapped(t *testing.T) {
+	const input = `status:open and (name:John or secret:*)`
+
+	ast, err := query.Parse("test", []byte(input))
+	require.NoError(t, err)
+
+	got, err := query.MapFields(ast.(query.Expr), query.FieldMap{"status": "u.status"})
+	require.Nil(t, got)
+	require.EqualError(t, err, `field "name" not found in schema; field "secret" not found in schema`)
+
+	errs := schema.ValidationErrors(err)
+	require.Len(t, errs, 2)
+	assert.Equal(t, schema.CodeUnknownField, errs[0].Code)
+
+	var spanErr *query.SpanError
+	require.ErrorAs(t, err, &spanErr)
+	assert.Equal(t, "name:John", input[spanErr.Start:spanErr.End])
+}
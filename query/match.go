@@ -2,6 +2,8 @@ package query
 
 import (
 	"strings"
+	"time"
+	"unicode"
 )
 
 type Matcher interface {
@@ -12,6 +14,12 @@ type Matcher interface {
 	MatchValue(target any, value Valuer, op FieldOperator) bool
 }
 
+// ExistsMatcher is an optional interface of Matcher for field existence checks (field:*). Matchers which don't
+// implement it evaluate the check as field != null with MatchField.
+type ExistsMatcher interface {
+	MatchExists(target any, field string) bool
+}
+
 func (b *BinaryExpr) Match(target any, matcher Matcher) bool {
 	switch b.Op {
 	case And:
@@ -28,15 +36,38 @@ func (n *NotExpr) Match(target any, matcher Matcher) bool {
 }
 
 func (f *FieldExpr) Match(target any, matcher Matcher) bool {
+	if f.Op == Exists {
+		if m, ok := matcher.(ExistsMatcher); ok {
+			return m.MatchExists(target, f.Field.String())
+		}
+
+		return matcher.MatchField(target, f.Field.String(), &NullLiteral{}, NotEqual)
+	}
+
 	return matcher.MatchField(target, f.Field.String(), f.Value, f.Op)
 }
 
+// Match checks if any of the term field expressions matches the target. Term without fields matches nothing.
+func (t *TermExpr) Match(target any, matcher Matcher) bool {
+	for _, f := range t.Fields {
+		if f.Match(target, matcher) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (s *StringLiteral) Match(target any, op FieldOperator) bool {
 	str, ok := target.(string)
 	if !ok {
 		return false
 	}
 
+	if s.CaseInsensitive && (op == Equal || op == NotEqual) {
+		return matchString(foldCase(str), foldCase(s.StringValue), op)
+	}
+
 	return matchString(str, s.StringValue, op)
 }
 
@@ -58,6 +89,115 @@ func (n *NumberLiteral) Match(target any, op FieldOperator) bool {
 	return matchNum(floatVal, n.NumberValue, op)
 }
 
+func (b *BooleanLiteral) Match(target any, op FieldOperator) bool {
+	boolVal, ok := target.(bool)
+	if !ok {
+		return false
+	}
+
+	return matchBool(boolVal, b.BooleanValue, op)
+}
+
+func (n *NullLiteral) Match(target any, op FieldOperator) bool {
+	switch op { //nolint:exhaustive
+	case Equal:
+		return target == nil
+	case NotEqual:
+		return target != nil
+	default:
+		return false
+	}
+}
+
+func (t *TimeLiteral) Match(target any, op FieldOperator) bool {
+	timeVal, ok := target.(time.Time)
+	if !ok {
+		return false
+	}
+
+	return matchTime(timeVal, t.TimeValue, op)
+}
+
+func (r *RelativeTimeLiteral) Match(target any, op FieldOperator) bool {
+	timeVal, ok := target.(time.Time)
+	if !ok {
+		return false
+	}
+
+	return matchTime(timeVal, r.Time(), op)
+}
+
+func (d *DurationLiteral) Match(target any, op FieldOperator) bool {
+	durationVal, ok := target.(time.Duration)
+	if !ok {
+		return false
+	}
+
+	return matchNum(durationVal, d.DurationValue, op)
+}
+
+func (w *WildcardLiteral) Match(target any, op FieldOperator) bool {
+	str, ok := target.(string)
+	if !ok {
+		return false
+	}
+
+	switch op { //nolint:exhaustive
+	case Equal, Like:
+		return matchWildcard(str, w.Pattern)
+	case ILike:
+		return matchWildcard(foldCase(str), foldCase(w.Pattern))
+	case NotEqual:
+		return !matchWildcard(str, w.Pattern)
+	default:
+		return false
+	}
+}
+
+// Match evaluates the LIKE pattern. The pattern must match the whole string as in SQL.
+func (l *LikePatternLiteral) Match(target any, op FieldOperator) bool {
+	str, ok := target.(string)
+	if !ok {
+		return false
+	}
+
+	switch op { //nolint:exhaustive
+	case Equal, Like:
+		return matchLike(str, l.Pattern)
+	case ILike:
+		return matchLike(foldCase(str), foldCase(l.Pattern))
+	case NotEqual:
+		return !matchLike(str, l.Pattern)
+	default:
+		return false
+	}
+}
+
+func (r *RegexLiteral) Match(target any, op FieldOperator) bool {
+	str, ok := target.(string)
+	if !ok {
+		return false
+	}
+
+	re, err := r.Regexp()
+	if op == ILike {
+		re, err = r.foldRegexp()
+	}
+
+	if err != nil {
+		return false
+	}
+
+	switch op { //nolint:exhaustive
+	case Equal, Like, ILike:
+		return re.MatchString(str)
+	case NotEqual:
+		return !re.MatchString(str)
+	default:
+		return false
+	}
+}
+
 func (i Identifier) Match(target any, op FieldOperator) bool {
 	str, ok := target.(string)
 	if !ok {
@@ -67,9 +207,11 @@ func (i Identifier) Match(target any, op FieldOperator) bool {
 	return matchString(str, i.String(), op)
 }
 
+// Match checks if the target matches any of the values for `=`, `~` and `~*` operators, and none of them for `!=`
+// operator.
 func (o *OneOfExpr) Match(target any, op FieldOperator) bool {
 	switch op { //nolint:exhaustive
-	case Equal, Like:
+	case Equal, Like, ILike:
 		for _, v := range o.Values {
 			if v.Match(target, op) {
 				return true
@@ -78,11 +220,58 @@ func (o *OneOfExpr) Match(target any, op FieldOperator) bool {
 
 		return false
 
+	case NotEqual:
+		for _, v := range o.Values {
+			if !v.Match(target, NotEqual) {
+				return false
+			}
+		}
+
+		return true
+
 	default:
 		return false
 	}
 }
 
+func (r *RangeExpr) Match(target any, op FieldOperator) bool {
+	if !r.comparableWith(target) {
+		return false
+	}
+
+	switch op { //nolint:exhaustive
+	case Equal:
+		return r.contains(target)
+	case NotEqual:
+		return !r.contains(target)
+	default:
+		return false
+	}
+}
+
+// comparableWith checks if the target has the same type as the range bounds.
+func (r *RangeExpr) comparableWith(target any) bool {
+	for _, bound := range []Valuer{r.Low, r.High} {
+		if bound != nil && !bound.Match(target, Equal) && !bound.Match(target, NotEqual) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *RangeExpr) contains(target any) bool {
+	lowOp, highOp := GreaterThanOrEqual, LessThanOrEqual
+	if r.LowExclusive {
+		lowOp = GreaterThan
+	}
+	if r.HighExclusive {
+		highOp = LessThan
+	}
+
+	return (r.Low == nil || r.Low.Match(target, lowOp)) && (r.High == nil || r.High.Match(target, highOp))
+}
+
 func matchString(a, b string, op FieldOperator) bool {
 	switch op { //nolint:exhaustive
 	case Equal:
@@ -91,12 +280,133 @@ func matchString(a, b string, op FieldOperator) bool {
 		return a != b
 	case Like:
 		return strings.Contains(a, b)
+	case ILike:
+		return strings.Contains(foldCase(a), foldCase(b))
+	default:
+		return false
+	}
+}
+
+// foldCase maps every rune to the smallest rune of its Unicode case folding orbit, so strings which are equal under
+// simple case folding become identical.
+func foldCase(s string) string {
+	return strings.Map(func(r rune) rune {
+		folded := r
+		for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+			folded = min(folded, f)
+		}
+
+		return folded
+	}, s)
+}
+
+// Pattern elements which match any single character and any sequence of characters. Literal runes are never negative.
+const (
+	anyChar     rune = -1
+	anySequence rune = -2
+)
+
+// matchWildcard reports whether the whole string matches glob pattern with `*` and `?` wildcards.
+func matchWildcard(s, pattern string) bool {
+	pat := []rune(pattern)
+
+	for i, r := range pat {
+		switch r {
+		case '*':
+			pat[i] = anySequence
+		case '?':
+			pat[i] = anyChar
+		}
+	}
+
+	return matchPattern([]rune(s), pat)
+}
+
+// matchLike reports whether the whole string matches LIKE pattern with `%` and `_` wildcards and backslash as escape
+// character.
+func matchLike(s, pattern string) bool {
+	pat := make([]rune, 0, len(pattern))
+	escaped := false
+
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			pat = append(pat, r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			pat = append(pat, anySequence)
+		case r == '_':
+			pat = append(pat, anyChar)
+		default:
+			pat = append(pat, r)
+		}
+	}
+
+	return matchPattern([]rune(s), pat)
+}
+
+func matchPattern(str, pat []rune) bool {
+	var (
+		si, pi         int
+		starPi, starSi = -1, 0
+	)
+
+	for si < len(str) {
+		switch {
+		case pi < len(pat) && (pat[pi] == anyChar || pat[pi] == str[si]):
+			si++
+			pi++
+		case pi < len(pat) && pat[pi] == anySequence:
+			starPi, starSi = pi, si
+			pi++
+		case starPi >= 0: // backtrack: let the last sequence wildcard consume one more character
+			starSi++
+			si, pi = starSi, starPi+1
+		default:
+			return false
+		}
+	}
+
+	for pi < len(pat) && pat[pi] == anySequence {
+		pi++
+	}
+
+	return pi == len(pat)
+}
+
+func matchBool(a, b bool, op FieldOperator) bool {
+	switch op { //nolint:exhaustive
+	case Equal:
+		return a == b
+	case NotEqual:
+		return a != b
+	default:
+		return false
+	}
+}
+
+func matchTime(a, b time.Time, op FieldOperator) bool {
+	switch op { //nolint:exhaustive
+	case Equal:
+		return a.Equal(b)
+	case NotEqual:
+		return !a.Equal(b)
+	case GreaterThan:
+		return a.After(b)
+	case GreaterThanOrEqual:
+		return !a.Before(b)
+	case LessThan:
+		return a.Before(b)
+	case LessThanOrEqual:
+		return !a.After(b)
 	default:
 		return false
 	}
 }
 
-func matchNum[T int64 | float64](a, b T, op FieldOperator) bool {
+func matchNum[T int64 | float64 | time.Duration](a, b T, op FieldOperator) bool {
 	switch op { //nolint:exhaustive
 	case Equal:
 		return a == b
@@ -1,10 +1,13 @@
 package query_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/defer-panic/dumbql/match"
 	"github.com/defer-panic/dumbql/query"
+	"github.com/defer-panic/dumbql/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -248,6 +251,40 @@ func TestFieldExpr_Match(t *testing.T) { //nolint:funlen
 			},
 			want: false,
 		},
+		{
+			name: "boolean field without dumbql tag",
+			expr: &query.FieldExpr{
+				Field: "IsMember",
+				Op:    query.Equal,
+				Value: &query.BooleanLiteral{BooleanValue: true},
+			},
+			want: true,
+		},
+		{
+			name: "boolean not equal - no match",
+			expr: &query.FieldExpr{
+				Field: "IsMember",
+				Op:    query.NotEqual,
+				Value: &query.BooleanLiteral{BooleanValue: true},
+			},
+			want: false,
+		},
+		{
+			name: "field exists",
+			expr: &query.FieldExpr{
+				Field: "name",
+				Op:    query.Exists,
+			},
+			want: true,
+		},
+		{
+			name: "non-existent field does not exist",
+			expr: &query.FieldExpr{
+				Field: "invalid",
+				Op:    query.Exists,
+			},
+			want: false,
+		},
 		{
 			name: "type mismatch",
 			expr: &query.FieldExpr{
@@ -267,6 +304,37 @@ func TestFieldExpr_Match(t *testing.T) { //nolint:funlen
 	}
 }
 
+// mapMatcher matches maps and doesn't implement query.ExistsMatcher.
+type mapMatcher struct{}
+
+func (m mapMatcher) MatchAnd(target any, left, right query.Expr) bool {
+	return left.Match(target, m) && right.Match(target, m)
+}
+
+func (m mapMatcher) MatchOr(target any, left, right query.Expr) bool {
+	return left.Match(target, m) || right.Match(target, m)
+}
+
+func (m mapMatcher) MatchNot(target any, expr query.Expr) bool {
+	return !expr.Match(target, m)
+}
+
+func (m mapMatcher) MatchField(target any, field string, value query.Valuer, op query.FieldOperator) bool {
+	return m.MatchValue(target.(map[string]any)[field], value, op)
+}
+
+func (m mapMatcher) MatchValue(target any, value query.Valuer, op query.FieldOperator) bool {
+	return value.Match(target, op)
+}
+
+func TestFieldExpr_Match_ExistsFallback(t *testing.T) {
+	target := map[string]any{"email": "john@example.com", "phone": nil}
+
+	assert.True(t, (&query.FieldExpr{Field: "email", Op: query.Exists}).Match(target, mapMatcher{}))
+	assert.False(t, (&query.FieldExpr{Field: "phone", Op: query.Exists}).Match(target, mapMatcher{}))
+	assert.False(t, (&query.FieldExpr{Field: "address", Op: query.Exists}).Match(target, mapMatcher{}))
+}
+
 func TestIdentifier_Match(t *testing.T) { //nolint:funlen
 	tests := []struct {
 		name   string
@@ -317,6 +385,34 @@ func TestIdentifier_Match(t *testing.T) { //nolint:funlen
 			op:     query.Like,
 			want:   false,
 		},
+		{
+			name:   "case-insensitive like - match",
+			id:     query.Identifier("WORLD"),
+			target: "Hello World",
+			op:     query.ILike,
+			want:   true,
+		},
+		{
+			name:   "case-insensitive like - unicode folding",
+			id:     query.Identifier("straẞe"),
+			target: "Hauptstraße",
+			op:     query.ILike,
+			want:   true,
+		},
+		{
+			name:   "case-insensitive like - greek sigma",
+			id:     query.Identifier("ΟΔΟΣ"),
+			target: "οδος",
+			op:     query.ILike,
+			want:   true,
+		},
+		{
+			name:   "case-insensitive like - no match",
+			id:     query.Identifier("universe"),
+			target: "Hello World",
+			op:     query.ILike,
+			want:   false,
+		},
 		{
 			name:   "with non-string target",
 			id:     query.Identifier("42"),
@@ -341,6 +437,392 @@ func TestIdentifier_Match(t *testing.T) { //nolint:funlen
 	}
 }
 
+func TestNullLiteral_Match(t *testing.T) {
+	tests := []struct {
+		name   string
+		target any
+		op     query.FieldOperator
+		want   bool
+	}{
+		{
+			name:   "equal - match",
+			target: nil,
+			op:     query.Equal,
+			want:   true,
+		},
+		{
+			name:   "equal - no match",
+			target: "test",
+			op:     query.Equal,
+			want:   false,
+		},
+		{
+			name:   "not equal - match",
+			target: int64(42),
+			op:     query.NotEqual,
+			want:   true,
+		},
+		{
+			name:   "not equal - no match",
+			target: nil,
+			op:     query.NotEqual,
+			want:   false,
+		},
+		{
+			name:   "with invalid operator",
+			target: nil,
+			op:     query.GreaterThan,
+			want:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := (&query.NullLiteral{}).Match(test.target, test.op)
+			assert.Equal(t, test.want, result)
+		})
+	}
+}
+
+func TestTimeLiteral_Match(t *testing.T) { //nolint:funlen
+	now := time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	tests := []struct {
+		name   string
+		value  query.Valuer
+		target any
+		op     query.FieldOperator
+		want   bool
+	}{
+		{
+			name:   "equal - match",
+			value:  &query.TimeLiteral{TimeValue: now},
+			target: now.In(time.FixedZone("UTC+2", 2*60*60)),
+			op:     query.Equal,
+			want:   true,
+		},
+		{
+			name:   "not equal - match",
+			value:  &query.TimeLiteral{TimeValue: now},
+			target: now.Add(time.Second),
+			op:     query.NotEqual,
+			want:   true,
+		},
+		{
+			name:   "greater than - match",
+			value:  &query.TimeLiteral{TimeValue: now},
+			target: now.Add(time.Hour),
+			op:     query.GreaterThan,
+			want:   true,
+		},
+		{
+			name:   "less than or equal - no match",
+			value:  &query.TimeLiteral{TimeValue: now},
+			target: now.Add(time.Hour),
+			op:     query.LessThanOrEqual,
+			want:   false,
+		},
+		{
+			name:   "relative greater than - match",
+			value:  &query.RelativeTimeLiteral{Offset: -7 * 24 * time.Hour, Now: clock},
+			target: now.Add(-24 * time.Hour),
+			op:     query.GreaterThan,
+			want:   true,
+		},
+		{
+			name:   "relative greater than - no match",
+			value:  &query.RelativeTimeLiteral{Offset: -7 * 24 * time.Hour, Now: clock},
+			target: now.Add(-8 * 24 * time.Hour),
+			op:     query.GreaterThan,
+			want:   false,
+		},
+		{
+			name:   "duration greater than or equal - match",
+			value:  &query.DurationLiteral{DurationValue: time.Hour},
+			target: 90 * time.Minute,
+			op:     query.GreaterThanOrEqual,
+			want:   true,
+		},
+		{
+			name:   "type mismatch",
+			value:  &query.TimeLiteral{TimeValue: now},
+			target: "2024-01-31T12:00:00Z",
+			op:     query.Equal,
+			want:   false,
+		},
+		{
+			name:   "relative type mismatch",
+			value:  &query.RelativeTimeLiteral{Now: clock},
+			target: now.Unix(),
+			op:     query.Equal,
+			want:   false,
+		},
+		{
+			name:   "duration type mismatch",
+			value:  &query.DurationLiteral{DurationValue: time.Hour},
+			target: int64(time.Hour),
+			op:     query.Equal,
+			want:   false,
+		},
+		{
+			name:   "with invalid operator",
+			value:  &query.TimeLiteral{TimeValue: now},
+			target: now,
+			op:     query.Like,
+			want:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := test.value.Match(test.target, test.op)
+			assert.Equal(t, test.want, result)
+		})
+	}
+}
+
+func TestRangeExpr_Match(t *testing.T) { //nolint:funlen
+	tests := []struct {
+		name   string
+		expr   *query.RangeExpr
+		target any
+		op     query.FieldOperator
+		want   bool
+	}{
+		{
+			name: "inclusive - match lower bound",
+			expr: &query.RangeExpr{
+				Low:  &query.IntegerLiteral{IntegerValue: 18},
+				High: &query.IntegerLiteral{IntegerValue: 65},
+			},
+			target: int64(18),
+			op:     query.Equal,
+			want:   true,
+		},
+		{
+			name: "exclusive - no match lower bound",
+			expr: &query.RangeExpr{
+				Low:          &query.IntegerLiteral{IntegerValue: 18},
+				High:         &query.IntegerLiteral{IntegerValue: 65},
+				LowExclusive: true,
+			},
+			target: int64(18),
+			op:     query.Equal,
+			want:   false,
+		},
+		{
+			name: "exclusive - no match upper bound",
+			expr: &query.RangeExpr{
+				Low:           &query.IntegerLiteral{IntegerValue: 18},
+				High:          &query.IntegerLiteral{IntegerValue: 65},
+				HighExclusive: true,
+			},
+			target: int64(65),
+			op:     query.Equal,
+			want:   false,
+		},
+		{
+			name: "unbounded - match",
+			expr: &query.RangeExpr{
+				Low: &query.NumberLiteral{NumberValue: 1.5},
+			},
+			target: 100.0,
+			op:     query.Equal,
+			want:   true,
+		},
+		{
+			name: "not equal - match outside",
+			expr: &query.RangeExpr{
+				Low:  &query.IntegerLiteral{IntegerValue: 18},
+				High: &query.IntegerLiteral{IntegerValue: 65},
+			},
+			target: int64(70),
+			op:     query.NotEqual,
+			want:   true,
+		},
+		{
+			name: "not equal - no match inside",
+			expr: &query.RangeExpr{
+				Low:  &query.IntegerLiteral{IntegerValue: 18},
+				High: &query.IntegerLiteral{IntegerValue: 65},
+			},
+			target: int64(30),
+			op:     query.NotEqual,
+			want:   false,
+		},
+		{
+			name: "type mismatch",
+			expr: &query.RangeExpr{
+				Low:  &query.IntegerLiteral{IntegerValue: 18},
+				High: &query.IntegerLiteral{IntegerValue: 65},
+			},
+			target: "30",
+			op:     query.NotEqual,
+			want:   false,
+		},
+		{
+			name: "invalid operator",
+			expr: &query.RangeExpr{
+				Low:  &query.IntegerLiteral{IntegerValue: 18},
+				High: &query.IntegerLiteral{IntegerValue: 65},
+			},
+			target: int64(30),
+			op:     query.GreaterThan,
+			want:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := test.expr.Match(test.target, test.op)
+			assert.Equal(t, test.want, result)
+		})
+	}
+}
+
+func TestWildcardLiteral_Match(t *testing.T) { //nolint:funlen
+	tests := []struct {
+		pattern string
+		target  any
+		op      query.FieldOperator
+		want    bool
+	}{
+		{pattern: "John*", target: "Johnny", op: query.Equal, want: true},
+		{pattern: "John*", target: "John", op: query.Equal, want: true},
+		{pattern: "John*", target: "Jon", op: query.Equal, want: false},
+		{pattern: "*son", target: "Jackson", op: query.Like, want: true},
+		{pattern: "*son", target: "Jacksons", op: query.Equal, want: false},
+		{pattern: "J?hn", target: "Jöhn", op: query.Equal, want: true},
+		{pattern: "J?hn", target: "Joohn", op: query.Equal, want: false},
+		{pattern: "*a*b*", target: "xxaxxbxx", op: query.Equal, want: true},
+		{pattern: "*a*b", target: "xxbxxa", op: query.Equal, want: false},
+		{pattern: "john*", target: "JOHNNY", op: query.ILike, want: true},
+		{pattern: "John*", target: "Jon", op: query.NotEqual, want: true},
+		{pattern: "John*", target: "Johnny", op: query.NotEqual, want: false},
+		{pattern: "John*", target: "Johnny", op: query.GreaterThan, want: false},
+		{pattern: "4*", target: int64(42), op: query.Equal, want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s %s %v", test.pattern, test.op, test.target), func(t *testing.T) {
+			result := (&query.WildcardLiteral{Pattern: test.pattern}).Match(test.target, test.op)
+			assert.Equal(t, test.want, result)
+		})
+	}
+}
+
+func TestLikePatternLiteral_Match(t *testing.T) {
+	tests := []struct {
+		pattern string
+		target  any
+		op      query.FieldOperator
+		want    bool
+	}{
+		{pattern: "J_hn%", target: "Johnny", op: query.Like, want: true},
+		{pattern: "J_hn%", target: "Jhn", op: query.Like, want: false},
+		{pattern: "%son", target: "Jackson", op: query.Like, want: true},
+		{pattern: "john", target: "Johnny", op: query.Like, want: false},
+		{pattern: `50\%`, target: "50%", op: query.Like, want: true},
+		{pattern: `50\%`, target: "500", op: query.Like, want: false},
+		{pattern: `a\_b`, target: "a_b", op: query.Like, want: true},
+		{pattern: `a\_b`, target: "axb", op: query.Like, want: false},
+		{pattern: "j_hn%", target: "JOHNNY", op: query.ILike, want: true},
+		{pattern: "J_hn%", target: "Jane", op: query.NotEqual, want: true},
+		{pattern: "%", target: int64(42), op: query.Like, want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s %s %v", test.pattern, test.op, test.target), func(t *testing.T) {
+			result := (&query.LikePatternLiteral{Pattern: test.pattern}).Match(test.target, test.op)
+			assert.Equal(t, test.want, result)
+		})
+	}
+}
+
+func TestRegexLiteral_Match(t *testing.T) {
+	tests := []struct {
+		name   string
+		regex  *query.RegexLiteral
+		target any
+		op     query.FieldOperator
+		want   bool
+	}{
+		{
+			name:   "match",
+			regex:  &query.RegexLiteral{Pattern: "^jo.n$"},
+			target: "john",
+			op:     query.Like,
+			want:   true,
+		},
+		{
+			name:   "case-sensitive - no match",
+			regex:  &query.RegexLiteral{Pattern: "^jo.n$"},
+			target: "JOHN",
+			op:     query.Equal,
+			want:   false,
+		},
+		{
+			name:   "case-insensitive - match",
+			regex:  &query.RegexLiteral{Pattern: "^jo.n$", CaseInsensitive: true},
+			target: "JOHN",
+			op:     query.Equal,
+			want:   true,
+		},
+		{
+			name:   "case-insensitive operator - match",
+			regex:  &query.RegexLiteral{Pattern: "^jo.n$"},
+			target: "JOHN",
+			op:     query.ILike,
+			want:   true,
+		},
+		{
+			name:   "not equal",
+			regex:  &query.RegexLiteral{Pattern: "^jo.n$"},
+			target: "jane",
+			op:     query.NotEqual,
+			want:   true,
+		},
+		{
+			name:   "non-string target",
+			regex:  &query.RegexLiteral{Pattern: "^4"},
+			target: int64(42),
+			op:     query.Equal,
+			want:   false,
+		},
+		{
+			name:   "invalid pattern",
+			regex:  &query.RegexLiteral{Pattern: "(jo"},
+			target: "jo",
+			op:     query.Equal,
+			want:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.regex.Match(test.target, test.op))
+		})
+	}
+}
+
+func TestRegexLiteral_Regexp_Compiled(t *testing.T) {
+	ast, err := query.Parse("test", []byte(`name ~ /^jo.n$/`))
+	require.NoError(t, err)
+
+	regex := ast.(*query.FieldExpr).Value.(*query.RegexLiteral)
+
+	first, err := regex.Regexp()
+	require.NoError(t, err)
+
+	second, err := regex.Regexp()
+	require.NoError(t, err)
+
+	assert.Same(t, first, second, "parsed pattern must be compiled once")
+	assert.True(t, regex.Match("JOHN", query.ILike))
+	assert.False(t, regex.Match("JOHN", query.Like))
+}
+
 func TestOneOfExpr_Match(t *testing.T) { //nolint:funlen
 	tests := []struct {
 		name   string
@@ -470,6 +952,50 @@ func TestOneOfExpr_Match(t *testing.T) { //nolint:funlen
 			op:     query.Like,
 			want:   true,
 		},
+		{
+			name: "not equal - match",
+			expr: &query.OneOfExpr{
+				Values: []query.Valuer{
+					&query.StringLiteral{StringValue: "apple"},
+					&query.StringLiteral{StringValue: "banana"},
+				},
+			},
+			target: "grape",
+			op:     query.NotEqual,
+			want:   true,
+		},
+		{
+			name: "not equal - no match",
+			expr: &query.OneOfExpr{
+				Values: []query.Valuer{
+					&query.StringLiteral{StringValue: "apple"},
+					&query.StringLiteral{StringValue: "banana"},
+				},
+			},
+			target: "banana",
+			op:     query.NotEqual,
+			want:   false,
+		},
+		{
+			name: "not equal - empty values",
+			expr: &query.OneOfExpr{
+				Values: []query.Valuer{},
+			},
+			target: "test",
+			op:     query.NotEqual,
+			want:   true,
+		},
+		{
+			name: "not equal - type mismatch",
+			expr: &query.OneOfExpr{
+				Values: []query.Valuer{
+					&query.StringLiteral{StringValue: "42"},
+				},
+			},
+			target: 42,
+			op:     query.NotEqual,
+			want:   false,
+		},
 		{
 			name: "invalid operator",
 			expr: &query.OneOfExpr{
@@ -502,6 +1028,51 @@ func TestOneOfExpr_Match(t *testing.T) { //nolint:funlen
 	}
 }
 
+func TestTermExpr_Match(t *testing.T) {
+	type Issue struct {
+		Title  string `dumbql:"title"`
+		Body   string `dumbql:"body"`
+		Status string `dumbql:"status"`
+	}
+
+	fields := schema.Fields{
+		"title":  {Searchable: true},
+		"body":   {Searchable: true, CaseInsensitive: true},
+		"status": {},
+	}
+
+	matcher := &match.StructMatcher{}
+	issue := &Issue{Title: "Broken pipe", Body: "Connection RESET by peer", Status: "open"}
+
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{query: `"Broken pipe"`, want: true},
+		{query: `broken`, want: false},
+		{query: `reset`, want: true},
+		{query: `open`, want: false},
+		{query: `pipe and status:open`, want: true},
+		{query: `not peer`, want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			ast, err := query.Parse("test", []byte(test.query))
+			require.NoError(t, err)
+
+			expr, err := ast.(query.Expr).Validate(fields)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.want, expr.Match(issue, matcher))
+		})
+	}
+
+	t.Run("not validated", func(t *testing.T) {
+		assert.False(t, (&query.TermExpr{Term: "pipe"}).Match(issue, matcher))
+	})
+}
+
 func TestStructFieldOmission(t *testing.T) { //nolint:funlen
 	type User struct {
 		ID       int64   `dumbql:"id"`
@@ -555,6 +1126,16 @@ func TestStructFieldOmission(t *testing.T) { //nolint:funlen
 			query: `unknown:"value"`,
 			want:  true,
 		},
+		{
+			name:  "visible field after omitted one",
+			query: `score:1.5`,
+			want:  false,
+		},
+		{
+			name:  "omitted field does not exist",
+			query: `Password:*`,
+			want:  false,
+		},
 	}
 
 	for _, test := range tests {
@@ -19,18 +19,45 @@ import (
 var g = &grammar{
 	rules: []*rule{
 		{
-			name: "Expr",
+			name: "Query",
 			pos:  position{line: 5, col: 1, offset: 23},
 			expr: &actionExpr{
 				pos: position{line: 5, col: 24, offset: 46},
-				run: (*parser).callonExpr1,
+				run: (*parser).callonQuery1,
 				expr: &seqExpr{
 					pos: position{line: 5, col: 24, offset: 46},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 5, col: 24, offset: 46},
+							label: "e",
+							expr: &ruleRefExpr{
+								pos:  position{line: 5, col: 26, offset: 48},
+								name: "Expr",
+							},
+						},
+						&notExpr{
+							pos: position{line: 67, col: 24, offset: 5509},
+							expr: &anyMatcher{
+								line: 67, col: 25, offset: 5510,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Expr",
+			pos:  position{line: 6, col: 1, offset: 118},
+			expr: &actionExpr{
+				pos: position{line: 6, col: 24, offset: 141},
+				run: (*parser).callonExpr1,
+				expr: &seqExpr{
+					pos: position{line: 6, col: 24, offset: 141},
 					exprs: []any{
 						&zeroOrMoreExpr{
-							pos: position{line: 33, col: 24, offset: 2101},
+							pos: position{line: 65, col: 24, offset: 5441},
 							expr: &charClassMatcher{
-								pos:        position{line: 33, col: 24, offset: 2101},
+								pos:        position{line: 65, col: 24, offset: 5441},
 								val:        "[ \\t\\r\\n]",
 								chars:      []rune{' ', '\t', '\r', '\n'},
 								ignoreCase: false,
@@ -38,17 +65,17 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 5, col: 26, offset: 48},
+							pos:   position{line: 6, col: 26, offset: 143},
 							label: "e",
 							expr: &ruleRefExpr{
-								pos:  position{line: 5, col: 28, offset: 50},
+								pos:  position{line: 6, col: 28, offset: 145},
 								name: "OrExpr",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 33, col: 24, offset: 2101},
+							pos: position{line: 65, col: 24, offset: 5441},
 							expr: &charClassMatcher{
-								pos:        position{line: 33, col: 24, offset: 2101},
+								pos:        position{line: 65, col: 24, offset: 5441},
 								val:        "[ \\t\\r\\n]",
 								chars:      []rune{' ', '\t', '\r', '\n'},
 								ignoreCase: false,
@@ -61,60 +88,102 @@ var g = &grammar{
 		},
 		{
 			name: "OrExpr",
-			pos:  position{line: 6, col: 1, offset: 118},
+			pos:  position{line: 7, col: 1, offset: 213},
 			expr: &actionExpr{
-				pos: position{line: 6, col: 24, offset: 141},
+				pos: position{line: 7, col: 24, offset: 236},
 				run: (*parser).callonOrExpr1,
 				expr: &seqExpr{
-					pos: position{line: 6, col: 24, offset: 141},
+					pos: position{line: 7, col: 24, offset: 236},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 6, col: 24, offset: 141},
+							pos:   position{line: 7, col: 24, offset: 236},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 6, col: 29, offset: 146},
+								pos:  position{line: 7, col: 29, offset: 241},
 								name: "AndExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 6, col: 37, offset: 154},
+							pos:   position{line: 7, col: 37, offset: 249},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 6, col: 42, offset: 159},
+								pos: position{line: 7, col: 42, offset: 254},
 								expr: &seqExpr{
-									pos: position{line: 6, col: 43, offset: 160},
+									pos: position{line: 7, col: 43, offset: 255},
 									exprs: []any{
 										&zeroOrMoreExpr{
-											pos: position{line: 33, col: 24, offset: 2101},
+											pos: position{line: 65, col: 24, offset: 5441},
 											expr: &charClassMatcher{
-												pos:        position{line: 33, col: 24, offset: 2101},
+												pos:        position{line: 65, col: 24, offset: 5441},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 										},
-										&choiceExpr{
-											pos: position{line: 7, col: 25, offset: 265},
-											alternatives: []any{
-												&litMatcher{
-													pos:        position{line: 7, col: 25, offset: 265},
-													val:        "OR",
-													ignoreCase: false,
-													want:       "\"OR\"",
-												},
-												&litMatcher{
-													pos:        position{line: 7, col: 32, offset: 272},
-													val:        "or",
-													ignoreCase: false,
-													want:       "\"or\"",
+										&actionExpr{
+											pos: position{line: 8, col: 24, offset: 359},
+											run: (*parser).callonOrExpr10,
+											expr: &seqExpr{
+												pos: position{line: 8, col: 24, offset: 359},
+												exprs: []any{
+													&choiceExpr{
+														pos: position{line: 8, col: 25, offset: 360},
+														alternatives: []any{
+															&litMatcher{
+																pos:        position{line: 8, col: 25, offset: 360},
+																val:        "OR",
+																ignoreCase: false,
+																want:       "\"OR\"",
+															},
+															&litMatcher{
+																pos:        position{line: 8, col: 32, offset: 367},
+																val:        "or",
+																ignoreCase: false,
+																want:       "\"or\"",
+															},
+														},
+													},
+													&notExpr{
+														pos: position{line: 27, col: 24, offset: 2310},
+														expr: &charClassMatcher{
+															pos:        position{line: 27, col: 25, offset: 2311},
+															val:        "[_a-zA-Z0-9]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+													&notExpr{
+														pos: position{line: 27, col: 38, offset: 2324},
+														expr: &seqExpr{
+															pos: position{line: 27, col: 41, offset: 2327},
+															exprs: []any{
+																&litMatcher{
+																	pos:        position{line: 27, col: 41, offset: 2327},
+																	val:        ".",
+																	ignoreCase: false,
+																	want:       "\".\"",
+																},
+																&charClassMatcher{
+																	pos:        position{line: 27, col: 45, offset: 2331},
+																	val:        "[_a-zA-Z0-9]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
+													},
 												},
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 33, col: 24, offset: 2101},
+											pos: position{line: 65, col: 24, offset: 5441},
 											expr: &charClassMatcher{
-												pos:        position{line: 33, col: 24, offset: 2101},
+												pos:        position{line: 65, col: 24, offset: 5441},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
@@ -122,7 +191,7 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 6, col: 56, offset: 173},
+											pos:  position{line: 7, col: 56, offset: 268},
 											name: "AndExpr",
 										},
 									},
@@ -135,33 +204,33 @@ var g = &grammar{
 		},
 		{
 			name: "AndExpr",
-			pos:  position{line: 8, col: 1, offset: 278},
+			pos:  position{line: 9, col: 1, offset: 436},
 			expr: &actionExpr{
-				pos: position{line: 8, col: 24, offset: 301},
+				pos: position{line: 9, col: 24, offset: 459},
 				run: (*parser).callonAndExpr1,
 				expr: &seqExpr{
-					pos: position{line: 8, col: 24, offset: 301},
+					pos: position{line: 9, col: 24, offset: 459},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 8, col: 24, offset: 301},
+							pos:   position{line: 9, col: 24, offset: 459},
 							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 8, col: 29, offset: 306},
+								pos:  position{line: 9, col: 29, offset: 464},
 								name: "NotExpr",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 8, col: 37, offset: 314},
+							pos:   position{line: 9, col: 37, offset: 472},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 8, col: 42, offset: 319},
+								pos: position{line: 9, col: 42, offset: 477},
 								expr: &seqExpr{
-									pos: position{line: 8, col: 43, offset: 320},
+									pos: position{line: 9, col: 43, offset: 478},
 									exprs: []any{
 										&zeroOrMoreExpr{
-											pos: position{line: 33, col: 24, offset: 2101},
+											pos: position{line: 65, col: 24, offset: 5441},
 											expr: &charClassMatcher{
-												pos:        position{line: 33, col: 24, offset: 2101},
+												pos:        position{line: 65, col: 24, offset: 5441},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
@@ -169,30 +238,72 @@ var g = &grammar{
 											},
 										},
 										&labeledExpr{
-											pos:   position{line: 8, col: 47, offset: 324},
+											pos:   position{line: 9, col: 47, offset: 482},
 											label: "op",
-											expr: &choiceExpr{
-												pos: position{line: 9, col: 25, offset: 425},
-												alternatives: []any{
-													&litMatcher{
-														pos:        position{line: 9, col: 25, offset: 425},
-														val:        "AND",
-														ignoreCase: false,
-														want:       "\"AND\"",
-													},
-													&litMatcher{
-														pos:        position{line: 9, col: 33, offset: 433},
-														val:        "and",
-														ignoreCase: false,
-														want:       "\"and\"",
+											expr: &actionExpr{
+												pos: position{line: 10, col: 24, offset: 582},
+												run: (*parser).callonAndExpr11,
+												expr: &seqExpr{
+													pos: position{line: 10, col: 24, offset: 582},
+													exprs: []any{
+														&choiceExpr{
+															pos: position{line: 10, col: 25, offset: 583},
+															alternatives: []any{
+																&litMatcher{
+																	pos:        position{line: 10, col: 25, offset: 583},
+																	val:        "AND",
+																	ignoreCase: false,
+																	want:       "\"AND\"",
+																},
+																&litMatcher{
+																	pos:        position{line: 10, col: 33, offset: 591},
+																	val:        "and",
+																	ignoreCase: false,
+																	want:       "\"and\"",
+																},
+															},
+														},
+														&notExpr{
+															pos: position{line: 27, col: 24, offset: 2310},
+															expr: &charClassMatcher{
+																pos:        position{line: 27, col: 25, offset: 2311},
+																val:        "[_a-zA-Z0-9]",
+																chars:      []rune{'_'},
+																ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+														&notExpr{
+															pos: position{line: 27, col: 38, offset: 2324},
+															expr: &seqExpr{
+																pos: position{line: 27, col: 41, offset: 2327},
+																exprs: []any{
+																	&litMatcher{
+																		pos:        position{line: 27, col: 41, offset: 2327},
+																		val:        ".",
+																		ignoreCase: false,
+																		want:       "\".\"",
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 27, col: 45, offset: 2331},
+																		val:        "[_a-zA-Z0-9]",
+																		chars:      []rune{'_'},
+																		ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
 													},
 												},
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 33, col: 24, offset: 2101},
+											pos: position{line: 65, col: 24, offset: 5441},
 											expr: &charClassMatcher{
-												pos:        position{line: 33, col: 24, offset: 2101},
+												pos:        position{line: 65, col: 24, offset: 5441},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
@@ -200,7 +311,7 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 8, col: 60, offset: 337},
+											pos:  position{line: 9, col: 60, offset: 495},
 											name: "NotExpr",
 										},
 									},
@@ -213,37 +324,70 @@ var g = &grammar{
 		},
 		{
 			name: "NotExpr",
-			pos:  position{line: 10, col: 1, offset: 440},
+			pos:  position{line: 11, col: 1, offset: 659},
 			expr: &choiceExpr{
-				pos: position{line: 10, col: 24, offset: 463},
+				pos: position{line: 11, col: 24, offset: 682},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 10, col: 24, offset: 463},
+						pos: position{line: 11, col: 24, offset: 682},
 						run: (*parser).callonNotExpr2,
 						expr: &seqExpr{
-							pos: position{line: 10, col: 24, offset: 463},
+							pos: position{line: 11, col: 24, offset: 682},
 							exprs: []any{
 								&choiceExpr{
-									pos: position{line: 10, col: 25, offset: 464},
+									pos: position{line: 11, col: 25, offset: 683},
 									alternatives: []any{
 										&litMatcher{
-											pos:        position{line: 10, col: 25, offset: 464},
+											pos:        position{line: 11, col: 25, offset: 683},
 											val:        "NOT",
 											ignoreCase: false,
 											want:       "\"NOT\"",
 										},
 										&litMatcher{
-											pos:        position{line: 10, col: 33, offset: 472},
+											pos:        position{line: 11, col: 33, offset: 691},
 											val:        "not",
 											ignoreCase: false,
 											want:       "\"not\"",
 										},
 									},
 								},
+								&notExpr{
+									pos: position{line: 27, col: 24, offset: 2310},
+									expr: &charClassMatcher{
+										pos:        position{line: 27, col: 25, offset: 2311},
+										val:        "[_a-zA-Z0-9]",
+										chars:      []rune{'_'},
+										ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&notExpr{
+									pos: position{line: 27, col: 38, offset: 2324},
+									expr: &seqExpr{
+										pos: position{line: 27, col: 41, offset: 2327},
+										exprs: []any{
+											&litMatcher{
+												pos:        position{line: 27, col: 41, offset: 2327},
+												val:        ".",
+												ignoreCase: false,
+												want:       "\".\"",
+											},
+											&charClassMatcher{
+												pos:        position{line: 27, col: 45, offset: 2331},
+												val:        "[_a-zA-Z0-9]",
+												chars:      []rune{'_'},
+												ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
 								&zeroOrMoreExpr{
-									pos: position{line: 33, col: 24, offset: 2101},
+									pos: position{line: 65, col: 24, offset: 5441},
 									expr: &charClassMatcher{
-										pos:        position{line: 33, col: 24, offset: 2101},
+										pos:        position{line: 65, col: 24, offset: 5441},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
@@ -251,10 +395,10 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 10, col: 42, offset: 481},
+									pos:   position{line: 11, col: 50, offset: 708},
 									label: "expr",
 									expr: &ruleRefExpr{
-										pos:  position{line: 10, col: 47, offset: 486},
+										pos:  position{line: 11, col: 55, offset: 713},
 										name: "Primary",
 									},
 								},
@@ -262,7 +406,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 11, col: 24, offset: 584},
+						pos:  position{line: 12, col: 24, offset: 798},
 						name: "Primary",
 					},
 				},
@@ -270,31 +414,31 @@ var g = &grammar{
 		},
 		{
 			name: "Primary",
-			pos:  position{line: 12, col: 1, offset: 592},
+			pos:  position{line: 13, col: 1, offset: 806},
 			expr: &choiceExpr{
-				pos: position{line: 12, col: 24, offset: 615},
+				pos: position{line: 13, col: 24, offset: 829},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 12, col: 24, offset: 615},
+						pos:  position{line: 13, col: 24, offset: 829},
 						name: "ParenExpr",
 					},
 					&actionExpr{
-						pos: position{line: 14, col: 24, offset: 766},
+						pos: position{line: 15, col: 24, offset: 1003},
 						run: (*parser).callonPrimary3,
 						expr: &seqExpr{
-							pos: position{line: 14, col: 24, offset: 766},
+							pos: position{line: 15, col: 24, offset: 1003},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 14, col: 24, offset: 766},
+									pos:   position{line: 15, col: 24, offset: 1003},
 									label: "field",
 									expr: &actionExpr{
-										pos: position{line: 17, col: 24, offset: 1009},
+										pos: position{line: 25, col: 24, offset: 2152},
 										run: (*parser).callonPrimary6,
 										expr: &seqExpr{
-											pos: position{line: 17, col: 24, offset: 1009},
+											pos: position{line: 25, col: 24, offset: 2152},
 											exprs: []any{
 												&charClassMatcher{
-													pos:        position{line: 18, col: 24, offset: 1121},
+													pos:        position{line: 26, col: 24, offset: 2264},
 													val:        "[_a-zA-Z]",
 													chars:      []rune{'_'},
 													ranges:     []rune{'a', 'z', 'A', 'Z'},
@@ -302,9 +446,9 @@ var g = &grammar{
 													inverted:   false,
 												},
 												&zeroOrMoreExpr{
-													pos: position{line: 18, col: 33, offset: 1130},
+													pos: position{line: 26, col: 33, offset: 2273},
 													expr: &charClassMatcher{
-														pos:        position{line: 18, col: 33, offset: 1130},
+														pos:        position{line: 26, col: 33, offset: 2273},
 														val:        "[_a-zA-Z0-9]",
 														chars:      []rune{'_'},
 														ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -313,18 +457,18 @@ var g = &grammar{
 													},
 												},
 												&zeroOrMoreExpr{
-													pos: position{line: 17, col: 37, offset: 1022},
+													pos: position{line: 25, col: 37, offset: 2165},
 													expr: &seqExpr{
-														pos: position{line: 17, col: 38, offset: 1023},
+														pos: position{line: 25, col: 38, offset: 2166},
 														exprs: []any{
 															&litMatcher{
-																pos:        position{line: 17, col: 38, offset: 1023},
+																pos:        position{line: 25, col: 38, offset: 2166},
 																val:        ".",
 																ignoreCase: false,
 																want:       "\".\"",
 															},
 															&charClassMatcher{
-																pos:        position{line: 18, col: 24, offset: 1121},
+																pos:        position{line: 26, col: 24, offset: 2264},
 																val:        "[_a-zA-Z]",
 																chars:      []rune{'_'},
 																ranges:     []rune{'a', 'z', 'A', 'Z'},
@@ -332,9 +476,9 @@ var g = &grammar{
 																inverted:   false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 18, col: 33, offset: 1130},
+																pos: position{line: 26, col: 33, offset: 2273},
 																expr: &charClassMatcher{
-																	pos:        position{line: 18, col: 33, offset: 1130},
+																	pos:        position{line: 26, col: 33, offset: 2273},
 																	val:        "[_a-zA-Z0-9]",
 																	chars:      []rune{'_'},
 																	ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -350,71 +494,127 @@ var g = &grammar{
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 33, col: 24, offset: 2101},
+									pos: position{line: 65, col: 24, offset: 5441},
+									expr: &charClassMatcher{
+										pos:        position{line: 65, col: 24, offset: 5441},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&charClassMatcher{
+									pos:        position{line: 52, col: 26, offset: 4322},
+									val:        "[:=]",
+									chars:      []rune{':', '='},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 65, col: 24, offset: 5441},
 									expr: &charClassMatcher{
-										pos:        position{line: 33, col: 24, offset: 2101},
+										pos:        position{line: 65, col: 24, offset: 5441},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 								},
+								&litMatcher{
+									pos:        position{line: 52, col: 40, offset: 4336},
+									val:        "*",
+									ignoreCase: false,
+									want:       "\"*\"",
+								},
+								&notExpr{
+									pos: position{line: 52, col: 44, offset: 4340},
+									expr: &charClassMatcher{
+										pos:        position{line: 43, col: 24, offset: 3785},
+										val:        "[_.*?-a-zA-Z0-9]",
+										chars:      []rune{'_', '.', '*', '?', '-'},
+										ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 16, col: 24, offset: 1123},
+						run: (*parser).callonPrimary25,
+						expr: &seqExpr{
+							pos: position{line: 16, col: 24, offset: 1123},
+							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 14, col: 43, offset: 785},
-									label: "op",
-									expr: &choiceExpr{
-										pos: position{line: 30, col: 26, offset: 1786},
-										alternatives: []any{
-											&litMatcher{
-												pos:        position{line: 30, col: 26, offset: 1786},
-												val:        ">=",
-												ignoreCase: false,
-												want:       "\">=\"",
-											},
-											&litMatcher{
-												pos:        position{line: 30, col: 33, offset: 1793},
-												val:        ">",
-												ignoreCase: false,
-												want:       "\">\"",
-											},
-											&litMatcher{
-												pos:        position{line: 30, col: 39, offset: 1799},
-												val:        "<=",
-												ignoreCase: false,
-												want:       "\"<=\"",
-											},
-											&litMatcher{
-												pos:        position{line: 30, col: 46, offset: 1806},
-												val:        "<",
-												ignoreCase: false,
-												want:       "\"<\"",
-											},
-											&litMatcher{
-												pos:        position{line: 30, col: 52, offset: 1812},
-												val:        "!:",
-												ignoreCase: false,
-												want:       "\"!:\"",
-											},
-											&litMatcher{
-												pos:        position{line: 30, col: 59, offset: 1819},
-												val:        "!=",
-												ignoreCase: false,
-												want:       "\"!=\"",
-											},
-											&charClassMatcher{
-												pos:        position{line: 30, col: 66, offset: 1826},
-												val:        "[:=~]",
-												chars:      []rune{':', '=', '~'},
-												ignoreCase: false,
-												inverted:   false,
+									pos:   position{line: 16, col: 24, offset: 1123},
+									label: "field",
+									expr: &actionExpr{
+										pos: position{line: 25, col: 24, offset: 2152},
+										run: (*parser).callonPrimary28,
+										expr: &seqExpr{
+											pos: position{line: 25, col: 24, offset: 2152},
+											exprs: []any{
+												&charClassMatcher{
+													pos:        position{line: 26, col: 24, offset: 2264},
+													val:        "[_a-zA-Z]",
+													chars:      []rune{'_'},
+													ranges:     []rune{'a', 'z', 'A', 'Z'},
+													ignoreCase: false,
+													inverted:   false,
+												},
+												&zeroOrMoreExpr{
+													pos: position{line: 26, col: 33, offset: 2273},
+													expr: &charClassMatcher{
+														pos:        position{line: 26, col: 33, offset: 2273},
+														val:        "[_a-zA-Z0-9]",
+														chars:      []rune{'_'},
+														ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+														ignoreCase: false,
+														inverted:   false,
+													},
+												},
+												&zeroOrMoreExpr{
+													pos: position{line: 25, col: 37, offset: 2165},
+													expr: &seqExpr{
+														pos: position{line: 25, col: 38, offset: 2166},
+														exprs: []any{
+															&litMatcher{
+																pos:        position{line: 25, col: 38, offset: 2166},
+																val:        ".",
+																ignoreCase: false,
+																want:       "\".\"",
+															},
+															&charClassMatcher{
+																pos:        position{line: 26, col: 24, offset: 2264},
+																val:        "[_a-zA-Z]",
+																chars:      []rune{'_'},
+																ranges:     []rune{'a', 'z', 'A', 'Z'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+															&zeroOrMoreExpr{
+																pos: position{line: 26, col: 33, offset: 2273},
+																expr: &charClassMatcher{
+																	pos:        position{line: 26, col: 33, offset: 2273},
+																	val:        "[_a-zA-Z0-9]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
+													},
+												},
 											},
 										},
 									},
 								},
-								&zeroOrMoreExpr{
-									pos: position{line: 33, col: 24, offset: 2101},
+								&oneOrMoreExpr{
+									pos: position{line: 66, col: 24, offset: 5475},
 									expr: &charClassMatcher{
-										pos:        position{line: 33, col: 24, offset: 2101},
+										pos:        position{line: 66, col: 24, offset: 5475},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
@@ -422,143 +622,222 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 14, col: 54, offset: 796},
-									label: "value",
-									expr: &choiceExpr{
-										pos: position{line: 15, col: 24, offset: 893},
-										alternatives: []any{
-											&actionExpr{
-												pos: position{line: 31, col: 24, offset: 1867},
-												run: (*parser).callonPrimary32,
-												expr: &seqExpr{
-													pos: position{line: 31, col: 24, offset: 1867},
+									pos:   position{line: 16, col: 44, offset: 1143},
+									label: "op",
+									expr: &actionExpr{
+										pos: position{line: 53, col: 24, offset: 4377},
+										run: (*parser).callonPrimary42,
+										expr: &choiceExpr{
+											pos: position{line: 53, col: 26, offset: 4379},
+											alternatives: []any{
+												&seqExpr{
+													pos: position{line: 53, col: 26, offset: 4379},
 													exprs: []any{
-														&litMatcher{
-															pos:        position{line: 31, col: 24, offset: 1867},
-															val:        "[",
-															ignoreCase: false,
-															want:       "\"[\"",
+														&choiceExpr{
+															pos: position{line: 53, col: 28, offset: 4381},
+															alternatives: []any{
+																&litMatcher{
+																	pos:        position{line: 53, col: 28, offset: 4381},
+																	val:        "NOT",
+																	ignoreCase: false,
+																	want:       "\"NOT\"",
+																},
+																&litMatcher{
+																	pos:        position{line: 53, col: 36, offset: 4389},
+																	val:        "not",
+																	ignoreCase: false,
+																	want:       "\"not\"",
+																},
+															},
 														},
-														&zeroOrMoreExpr{
-															pos: position{line: 33, col: 24, offset: 2101},
+														&oneOrMoreExpr{
+															pos: position{line: 66, col: 24, offset: 5475},
 															expr: &charClassMatcher{
-																pos:        position{line: 33, col: 24, offset: 2101},
+																pos:        position{line: 66, col: 24, offset: 5475},
 																val:        "[ \\t\\r\\n]",
 																chars:      []rune{' ', '\t', '\r', '\n'},
 																ignoreCase: false,
 																inverted:   false,
 															},
 														},
-														&labeledExpr{
-															pos:   position{line: 31, col: 30, offset: 1873},
-															label: "values",
-															expr: &zeroOrOneExpr{
-																pos: position{line: 31, col: 37, offset: 1880},
-																expr: &actionExpr{
-																	pos: position{line: 32, col: 24, offset: 1984},
-																	run: (*parser).callonPrimary39,
-																	expr: &seqExpr{
-																		pos: position{line: 32, col: 24, offset: 1984},
-																		exprs: []any{
-																			&labeledExpr{
-																				pos:   position{line: 32, col: 24, offset: 1984},
-																				label: "head",
-																				expr: &choiceExpr{
-																					pos: position{line: 16, col: 24, offset: 957},
-																					alternatives: []any{
-																						&actionExpr{
-																							pos: position{line: 23, col: 24, offset: 1391},
-																							run: (*parser).callonPrimary43,
-																							expr: &seqExpr{
-																								pos: position{line: 23, col: 24, offset: 1391},
-																								exprs: []any{
-																									&litMatcher{
-																										pos:        position{line: 23, col: 24, offset: 1391},
-																										val:        "\"",
-																										ignoreCase: false,
-																										want:       "\"\\\"\"",
-																									},
-																									&zeroOrMoreExpr{
-																										pos: position{line: 24, col: 24, offset: 1494},
-																										expr: &choiceExpr{
-																											pos: position{line: 24, col: 26, offset: 1496},
-																											alternatives: []any{
-																												&seqExpr{
-																													pos: position{line: 24, col: 26, offset: 1496},
-																													exprs: []any{
-																														&notExpr{
-																															pos: position{line: 24, col: 26, offset: 1496},
-																															expr: &charClassMatcher{
-																																pos:        position{line: 25, col: 24, offset: 1559},
-																																val:        "[\"\\\\\\x00-\\x1f]",
-																																chars:      []rune{'"', '\\'},
-																																ranges:     []rune{'\x00', '\x1f'},
-																																ignoreCase: false,
-																																inverted:   false,
-																															},
-																														},
-																														&anyMatcher{
-																															line: 24, col: 39, offset: 1509,
-																														},
+														&choiceExpr{
+															pos: position{line: 53, col: 49, offset: 4402},
+															alternatives: []any{
+																&litMatcher{
+																	pos:        position{line: 53, col: 49, offset: 4402},
+																	val:        "IN",
+																	ignoreCase: false,
+																	want:       "\"IN\"",
+																},
+																&litMatcher{
+																	pos:        position{line: 53, col: 56, offset: 4409},
+																	val:        "in",
+																	ignoreCase: false,
+																	want:       "\"in\"",
+																},
+															},
+														},
+													},
+												},
+												&litMatcher{
+													pos:        position{line: 53, col: 65, offset: 4418},
+													val:        "IN",
+													ignoreCase: false,
+													want:       "\"IN\"",
+												},
+												&litMatcher{
+													pos:        position{line: 53, col: 72, offset: 4425},
+													val:        "in",
+													ignoreCase: false,
+													want:       "\"in\"",
+												},
+											},
+										},
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 65, col: 24, offset: 5441},
+									expr: &charClassMatcher{
+										pos:        position{line: 65, col: 24, offset: 5441},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 16, col: 54, offset: 1153},
+									label: "value",
+									expr: &actionExpr{
+										pos: position{line: 55, col: 24, offset: 4568},
+										run: (*parser).callonPrimary58,
+										expr: &seqExpr{
+											pos: position{line: 55, col: 24, offset: 4568},
+											exprs: []any{
+												&litMatcher{
+													pos:        position{line: 55, col: 24, offset: 4568},
+													val:        "[",
+													ignoreCase: false,
+													want:       "\"[\"",
+												},
+												&zeroOrMoreExpr{
+													pos: position{line: 65, col: 24, offset: 5441},
+													expr: &charClassMatcher{
+														pos:        position{line: 65, col: 24, offset: 5441},
+														val:        "[ \\t\\r\\n]",
+														chars:      []rune{' ', '\t', '\r', '\n'},
+														ignoreCase: false,
+														inverted:   false,
+													},
+												},
+												&labeledExpr{
+													pos:   position{line: 55, col: 30, offset: 4574},
+													label: "values",
+													expr: &zeroOrOneExpr{
+														pos: position{line: 55, col: 37, offset: 4581},
+														expr: &actionExpr{
+															pos: position{line: 56, col: 24, offset: 4688},
+															run: (*parser).callonPrimary65,
+															expr: &seqExpr{
+																pos: position{line: 56, col: 24, offset: 4688},
+																exprs: []any{
+																	&labeledExpr{
+																		pos:   position{line: 56, col: 24, offset: 4688},
+																		label: "head",
+																		expr: &choiceExpr{
+																			pos: position{line: 23, col: 24, offset: 1899},
+																			alternatives: []any{
+																				&actionExpr{
+																					pos: position{line: 45, col: 24, offset: 3927},
+																					run: (*parser).callonPrimary69,
+																					expr: &seqExpr{
+																						pos: position{line: 45, col: 24, offset: 3927},
+																						exprs: []any{
+																							&litMatcher{
+																								pos:        position{line: 45, col: 24, offset: 3927},
+																								val:        "\"",
+																								ignoreCase: false,
+																								want:       "\"\\\"\"",
+																							},
+																							&zeroOrMoreExpr{
+																								pos: position{line: 46, col: 24, offset: 4030},
+																								expr: &choiceExpr{
+																									pos: position{line: 46, col: 26, offset: 4032},
+																									alternatives: []any{
+																										&seqExpr{
+																											pos: position{line: 46, col: 26, offset: 4032},
+																											exprs: []any{
+																												&notExpr{
+																													pos: position{line: 46, col: 26, offset: 4032},
+																													expr: &charClassMatcher{
+																														pos:        position{line: 47, col: 24, offset: 4095},
+																														val:        "[\"\\\\\\x00-\\x1f]",
+																														chars:      []rune{'"', '\\'},
+																														ranges:     []rune{'\x00', '\x1f'},
+																														ignoreCase: false,
+																														inverted:   false,
 																													},
 																												},
-																												&seqExpr{
-																													pos: position{line: 24, col: 43, offset: 1513},
-																													exprs: []any{
-																														&litMatcher{
-																															pos:        position{line: 24, col: 43, offset: 1513},
-																															val:        "\\",
+																												&anyMatcher{
+																													line: 46, col: 39, offset: 4045,
+																												},
+																											},
+																										},
+																										&seqExpr{
+																											pos: position{line: 46, col: 43, offset: 4049},
+																											exprs: []any{
+																												&litMatcher{
+																													pos:        position{line: 46, col: 43, offset: 4049},
+																													val:        "\\",
+																													ignoreCase: false,
+																													want:       "\"\\\\\"",
+																												},
+																												&choiceExpr{
+																													pos: position{line: 48, col: 24, offset: 4133},
+																													alternatives: []any{
+																														&charClassMatcher{
+																															pos:        position{line: 49, col: 24, offset: 4189},
+																															val:        "[\"\\\\/bfnrt]",
+																															chars:      []rune{'"', '\\', '/', 'b', 'f', 'n', 'r', 't'},
 																															ignoreCase: false,
-																															want:       "\"\\\\\"",
+																															inverted:   false,
 																														},
-																														&choiceExpr{
-																															pos: position{line: 26, col: 24, offset: 1597},
-																															alternatives: []any{
-																																&charClassMatcher{
-																																	pos:        position{line: 27, col: 24, offset: 1653},
-																																	val:        "[\"\\\\/bfnrt]",
-																																	chars:      []rune{'"', '\\', '/', 'b', 'f', 'n', 'r', 't'},
+																														&seqExpr{
+																															pos: position{line: 50, col: 24, offset: 4224},
+																															exprs: []any{
+																																&litMatcher{
+																																	pos:        position{line: 50, col: 24, offset: 4224},
+																																	val:        "u",
 																																	ignoreCase: false,
+																																	want:       "\"u\"",
+																																},
+																																&charClassMatcher{
+																																	pos:        position{line: 51, col: 24, offset: 4287},
+																																	val:        "[0-9a-f]i",
+																																	ranges:     []rune{'0', '9', 'a', 'f'},
+																																	ignoreCase: true,
 																																	inverted:   false,
 																																},
-																																&seqExpr{
-																																	pos: position{line: 28, col: 24, offset: 1688},
-																																	exprs: []any{
-																																		&litMatcher{
-																																			pos:        position{line: 28, col: 24, offset: 1688},
-																																			val:        "u",
-																																			ignoreCase: false,
-																																			want:       "\"u\"",
-																																		},
-																																		&charClassMatcher{
-																																			pos:        position{line: 29, col: 24, offset: 1751},
-																																			val:        "[0-9a-f]i",
-																																			ranges:     []rune{'0', '9', 'a', 'f'},
-																																			ignoreCase: true,
-																																			inverted:   false,
-																																		},
-																																		&charClassMatcher{
-																																			pos:        position{line: 29, col: 24, offset: 1751},
-																																			val:        "[0-9a-f]i",
-																																			ranges:     []rune{'0', '9', 'a', 'f'},
-																																			ignoreCase: true,
-																																			inverted:   false,
-																																		},
-																																		&charClassMatcher{
-																																			pos:        position{line: 29, col: 24, offset: 1751},
-																																			val:        "[0-9a-f]i",
-																																			ranges:     []rune{'0', '9', 'a', 'f'},
-																																			ignoreCase: true,
-																																			inverted:   false,
-																																		},
-																																		&charClassMatcher{
-																																			pos:        position{line: 29, col: 24, offset: 1751},
-																																			val:        "[0-9a-f]i",
-																																			ranges:     []rune{'0', '9', 'a', 'f'},
-																																			ignoreCase: true,
-																																			inverted:   false,
-																																		},
-																																	},
+																																&charClassMatcher{
+																																	pos:        position{line: 51, col: 24, offset: 4287},
+																																	val:        "[0-9a-f]i",
+																																	ranges:     []rune{'0', '9', 'a', 'f'},
+																																	ignoreCase: true,
+																																	inverted:   false,
+																																},
+																																&charClassMatcher{
+																																	pos:        position{line: 51, col: 24, offset: 4287},
+																																	val:        "[0-9a-f]i",
+																																	ranges:     []rune{'0', '9', 'a', 'f'},
+																																	ignoreCase: true,
+																																	inverted:   false,
+																																},
+																																&charClassMatcher{
+																																	pos:        position{line: 51, col: 24, offset: 4287},
+																																	val:        "[0-9a-f]i",
+																																	ranges:     []rune{'0', '9', 'a', 'f'},
+																																	ignoreCase: true,
+																																	inverted:   false,
 																																},
 																															},
 																														},
@@ -567,53 +846,172 @@ var g = &grammar{
 																											},
 																										},
 																									},
-																									&litMatcher{
-																										pos:        position{line: 23, col: 40, offset: 1407},
-																										val:        "\"",
-																										ignoreCase: false,
-																										want:       "\"\\\"\"",
-																									},
 																								},
 																							},
+																							&litMatcher{
+																								pos:        position{line: 45, col: 40, offset: 3943},
+																								val:        "\"",
+																								ignoreCase: false,
+																								want:       "\"\\\"\"",
+																							},
 																						},
-																						&actionExpr{
-																							pos: position{line: 20, col: 24, offset: 1230},
-																							run: (*parser).callonPrimary63,
-																							expr: &seqExpr{
-																								pos: position{line: 20, col: 24, offset: 1230},
-																								exprs: []any{
-																									&zeroOrOneExpr{
-																										pos: position{line: 20, col: 24, offset: 1230},
-																										expr: &litMatcher{
-																											pos:        position{line: 20, col: 24, offset: 1230},
-																											val:        "-",
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 33, col: 24, offset: 2791},
+																					run: (*parser).callonPrimary89,
+																					expr: &seqExpr{
+																						pos: position{line: 33, col: 24, offset: 2791},
+																						exprs: []any{
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&litMatcher{
+																								pos:        position{line: 34, col: 76, offset: 2948},
+																								val:        "-",
+																								ignoreCase: false,
+																								want:       "\"-\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&litMatcher{
+																								pos:        position{line: 34, col: 106, offset: 2978},
+																								val:        "-",
+																								ignoreCase: false,
+																								want:       "\"-\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&zeroOrOneExpr{
+																								pos: position{line: 33, col: 29, offset: 2796},
+																								expr: &seqExpr{
+																									pos: position{line: 33, col: 31, offset: 2798},
+																									exprs: []any{
+																										&charClassMatcher{
+																											pos:        position{line: 33, col: 31, offset: 2798},
+																											val:        "[Tt]",
+																											chars:      []rune{'T', 't'},
 																											ignoreCase: false,
-																											want:       "\"-\"",
+																											inverted:   false,
 																										},
-																									},
-																									&choiceExpr{
-																										pos: position{line: 19, col: 24, offset: 1167},
-																										alternatives: []any{
-																											&litMatcher{
-																												pos:        position{line: 19, col: 24, offset: 1167},
-																												val:        "0",
-																												ignoreCase: false,
-																												want:       "\"0\"",
-																											},
-																											&seqExpr{
-																												pos: position{line: 19, col: 30, offset: 1173},
+																										&charClassMatcher{
+																											pos:        position{line: 32, col: 24, offset: 2762},
+																											val:        "[0-9]",
+																											ranges:     []rune{'0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 32, col: 24, offset: 2762},
+																											val:        "[0-9]",
+																											ranges:     []rune{'0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																										&litMatcher{
+																											pos:        position{line: 35, col: 50, offset: 3057},
+																											val:        ":",
+																											ignoreCase: false,
+																											want:       "\":\"",
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 32, col: 24, offset: 2762},
+																											val:        "[0-9]",
+																											ranges:     []rune{'0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 32, col: 24, offset: 2762},
+																											val:        "[0-9]",
+																											ranges:     []rune{'0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																										&litMatcher{
+																											pos:        position{line: 35, col: 80, offset: 3087},
+																											val:        ":",
+																											ignoreCase: false,
+																											want:       "\":\"",
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 32, col: 24, offset: 2762},
+																											val:        "[0-9]",
+																											ranges:     []rune{'0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 32, col: 24, offset: 2762},
+																											val:        "[0-9]",
+																											ranges:     []rune{'0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																										&zeroOrOneExpr{
+																											pos: position{line: 35, col: 110, offset: 3117},
+																											expr: &seqExpr{
+																												pos: position{line: 35, col: 112, offset: 3119},
 																												exprs: []any{
-																													&charClassMatcher{
-																														pos:        position{line: 22, col: 24, offset: 1362},
-																														val:        "[1-9]",
-																														ranges:     []rune{'1', '9'},
+																													&litMatcher{
+																														pos:        position{line: 35, col: 112, offset: 3119},
+																														val:        ".",
 																														ignoreCase: false,
-																														inverted:   false,
+																														want:       "\".\"",
 																													},
-																													&zeroOrMoreExpr{
-																														pos: position{line: 19, col: 50, offset: 1193},
+																													&oneOrMoreExpr{
+																														pos: position{line: 35, col: 116, offset: 3123},
 																														expr: &charClassMatcher{
-																															pos:        position{line: 21, col: 24, offset: 1333},
+																															pos:        position{line: 32, col: 24, offset: 2762},
 																															val:        "[0-9]",
 																															ranges:     []rune{'0', '9'},
 																															ignoreCase: false,
@@ -623,52 +1021,202 @@ var g = &grammar{
 																												},
 																											},
 																										},
-																									},
-																									&zeroOrOneExpr{
-																										pos: position{line: 20, col: 37, offset: 1243},
-																										expr: &seqExpr{
-																											pos: position{line: 20, col: 39, offset: 1245},
-																											exprs: []any{
-																												&litMatcher{
-																													pos:        position{line: 20, col: 39, offset: 1245},
-																													val:        ".",
+																										&choiceExpr{
+																											pos: position{line: 36, col: 24, offset: 3172},
+																											alternatives: []any{
+																												&charClassMatcher{
+																													pos:        position{line: 36, col: 24, offset: 3172},
+																													val:        "[Zz]",
+																													chars:      []rune{'Z', 'z'},
 																													ignoreCase: false,
-																													want:       "\".\"",
-																												},
-																												&oneOrMoreExpr{
-																													pos: position{line: 20, col: 43, offset: 1249},
-																													expr: &charClassMatcher{
-																														pos:        position{line: 21, col: 24, offset: 1333},
-																														val:        "[0-9]",
-																														ranges:     []rune{'0', '9'},
-																														ignoreCase: false,
-																														inverted:   false,
-																													},
+																													inverted:   false,
 																												},
-																											},
-																										},
+																												&seqExpr{
+																													pos: position{line: 36, col: 31, offset: 3179},
+																													exprs: []any{
+																														&charClassMatcher{
+																															pos:        position{line: 36, col: 31, offset: 3179},
+																															val:        "[+-]",
+																															chars:      []rune{'+', '-'},
+																															ignoreCase: false,
+																															inverted:   false,
+																														},
+																														&charClassMatcher{
+																															pos:        position{line: 32, col: 24, offset: 2762},
+																															val:        "[0-9]",
+																															ranges:     []rune{'0', '9'},
+																															ignoreCase: false,
+																															inverted:   false,
+																														},
+																														&charClassMatcher{
+																															pos:        position{line: 32, col: 24, offset: 2762},
+																															val:        "[0-9]",
+																															ranges:     []rune{'0', '9'},
+																															ignoreCase: false,
+																															inverted:   false,
+																														},
+																														&litMatcher{
+																															pos:        position{line: 36, col: 62, offset: 3210},
+																															val:        ":",
+																															ignoreCase: false,
+																															want:       "\":\"",
+																														},
+																														&charClassMatcher{
+																															pos:        position{line: 32, col: 24, offset: 2762},
+																															val:        "[0-9]",
+																															ranges:     []rune{'0', '9'},
+																															ignoreCase: false,
+																															inverted:   false,
+																														},
+																														&charClassMatcher{
+																															pos:        position{line: 32, col: 24, offset: 2762},
+																															val:        "[0-9]",
+																															ranges:     []rune{'0', '9'},
+																															ignoreCase: false,
+																															inverted:   false,
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																							&notExpr{
+																								pos: position{line: 27, col: 24, offset: 2310},
+																								expr: &charClassMatcher{
+																									pos:        position{line: 27, col: 25, offset: 2311},
+																									val:        "[_a-zA-Z0-9]",
+																									chars:      []rune{'_'},
+																									ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																							},
+																							&notExpr{
+																								pos: position{line: 27, col: 38, offset: 2324},
+																								expr: &seqExpr{
+																									pos: position{line: 27, col: 41, offset: 2327},
+																									exprs: []any{
+																										&litMatcher{
+																											pos:        position{line: 27, col: 41, offset: 2327},
+																											val:        ".",
+																											ignoreCase: false,
+																											want:       "\".\"",
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 27, col: 45, offset: 2331},
+																											val:        "[_a-zA-Z0-9]",
+																											chars:      []rune{'_'},
+																											ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
 																									},
 																								},
 																							},
 																						},
-																						&actionExpr{
-																							pos: position{line: 17, col: 24, offset: 1009},
-																							run: (*parser).callonPrimary78,
-																							expr: &seqExpr{
-																								pos: position{line: 17, col: 24, offset: 1009},
-																								exprs: []any{
-																									&charClassMatcher{
-																										pos:        position{line: 18, col: 24, offset: 1121},
-																										val:        "[_a-zA-Z]",
-																										chars:      []rune{'_'},
-																										ranges:     []rune{'a', 'z', 'A', 'Z'},
-																										ignoreCase: false,
-																										inverted:   false,
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 37, col: 24, offset: 3263},
+																					run: (*parser).callonPrimary132,
+																					expr: &seqExpr{
+																						pos: position{line: 37, col: 24, offset: 3263},
+																						exprs: []any{
+																							&litMatcher{
+																								pos:        position{line: 37, col: 24, offset: 3263},
+																								val:        "now",
+																								ignoreCase: false,
+																								want:       "\"now\"",
+																							},
+																							&zeroOrOneExpr{
+																								pos: position{line: 37, col: 30, offset: 3269},
+																								expr: &seqExpr{
+																									pos: position{line: 37, col: 32, offset: 3271},
+																									exprs: []any{
+																										&charClassMatcher{
+																											pos:        position{line: 37, col: 32, offset: 3271},
+																											val:        "[+-]",
+																											chars:      []rune{'+', '-'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																										&oneOrMoreExpr{
+																											pos: position{line: 39, col: 24, offset: 3477},
+																											expr: &seqExpr{
+																												pos: position{line: 39, col: 26, offset: 3479},
+																												exprs: []any{
+																													&oneOrMoreExpr{
+																														pos: position{line: 39, col: 26, offset: 3479},
+																														expr: &charClassMatcher{
+																															pos:        position{line: 32, col: 24, offset: 2762},
+																															val:        "[0-9]",
+																															ranges:     []rune{'0', '9'},
+																															ignoreCase: false,
+																															inverted:   false,
+																														},
+																													},
+																													&choiceExpr{
+																														pos: position{line: 40, col: 24, offset: 3532},
+																														alternatives: []any{
+																															&litMatcher{
+																																pos:        position{line: 40, col: 24, offset: 3532},
+																																val:        "ns",
+																																ignoreCase: false,
+																																want:       "\"ns\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 40, col: 31, offset: 3539},
+																																val:        "us",
+																																ignoreCase: false,
+																																want:       "\"us\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 40, col: 38, offset: 3546},
+																																val:        "ms",
+																																ignoreCase: false,
+																																want:       "\"ms\"",
+																															},
+																															&charClassMatcher{
+																																pos:        position{line: 40, col: 45, offset: 3553},
+																																val:        "[smhdw]",
+																																chars:      []rune{'s', 'm', 'h', 'd', 'w'},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																														},
+																													},
+																												},
+																											},
+																										},
 																									},
-																									&zeroOrMoreExpr{
-																										pos: position{line: 18, col: 33, offset: 1130},
-																										expr: &charClassMatcher{
-																											pos:        position{line: 18, col: 33, offset: 1130},
+																								},
+																							},
+																							&notExpr{
+																								pos: position{line: 27, col: 24, offset: 2310},
+																								expr: &charClassMatcher{
+																									pos:        position{line: 27, col: 25, offset: 2311},
+																									val:        "[_a-zA-Z0-9]",
+																									chars:      []rune{'_'},
+																									ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																							},
+																							&notExpr{
+																								pos: position{line: 27, col: 38, offset: 2324},
+																								expr: &seqExpr{
+																									pos: position{line: 27, col: 41, offset: 2327},
+																									exprs: []any{
+																										&litMatcher{
+																											pos:        position{line: 27, col: 41, offset: 2327},
+																											val:        ".",
+																											ignoreCase: false,
+																											want:       "\".\"",
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 27, col: 45, offset: 2331},
 																											val:        "[_a-zA-Z0-9]",
 																											chars:      []rune{'_'},
 																											ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -676,175 +1224,452 @@ var g = &grammar{
 																											inverted:   false,
 																										},
 																									},
-																									&zeroOrMoreExpr{
-																										pos: position{line: 17, col: 37, offset: 1022},
-																										expr: &seqExpr{
-																											pos: position{line: 17, col: 38, offset: 1023},
-																											exprs: []any{
+																								},
+																							},
+																						},
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 38, col: 24, offset: 3372},
+																					run: (*parser).callonPrimary153,
+																					expr: &seqExpr{
+																						pos: position{line: 38, col: 24, offset: 3372},
+																						exprs: []any{
+																							&zeroOrOneExpr{
+																								pos: position{line: 38, col: 24, offset: 3372},
+																								expr: &litMatcher{
+																									pos:        position{line: 38, col: 24, offset: 3372},
+																									val:        "-",
+																									ignoreCase: false,
+																									want:       "\"-\"",
+																								},
+																							},
+																							&oneOrMoreExpr{
+																								pos: position{line: 39, col: 24, offset: 3477},
+																								expr: &seqExpr{
+																									pos: position{line: 39, col: 26, offset: 3479},
+																									exprs: []any{
+																										&oneOrMoreExpr{
+																											pos: position{line: 39, col: 26, offset: 3479},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																										&choiceExpr{
+																											pos: position{line: 40, col: 24, offset: 3532},
+																											alternatives: []any{
 																												&litMatcher{
-																													pos:        position{line: 17, col: 38, offset: 1023},
-																													val:        ".",
+																													pos:        position{line: 40, col: 24, offset: 3532},
+																													val:        "ns",
 																													ignoreCase: false,
-																													want:       "\".\"",
+																													want:       "\"ns\"",
+																												},
+																												&litMatcher{
+																													pos:        position{line: 40, col: 31, offset: 3539},
+																													val:        "us",
+																													ignoreCase: false,
+																													want:       "\"us\"",
+																												},
+																												&litMatcher{
+																													pos:        position{line: 40, col: 38, offset: 3546},
+																													val:        "ms",
+																													ignoreCase: false,
+																													want:       "\"ms\"",
 																												},
 																												&charClassMatcher{
-																													pos:        position{line: 18, col: 24, offset: 1121},
-																													val:        "[_a-zA-Z]",
-																													chars:      []rune{'_'},
-																													ranges:     []rune{'a', 'z', 'A', 'Z'},
+																													pos:        position{line: 40, col: 45, offset: 3553},
+																													val:        "[smhdw]",
+																													chars:      []rune{'s', 'm', 'h', 'd', 'w'},
 																													ignoreCase: false,
 																													inverted:   false,
 																												},
-																												&zeroOrMoreExpr{
-																													pos: position{line: 18, col: 33, offset: 1130},
-																													expr: &charClassMatcher{
-																														pos:        position{line: 18, col: 33, offset: 1130},
-																														val:        "[_a-zA-Z0-9]",
-																														chars:      []rune{'_'},
-																														ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
-																														ignoreCase: false,
-																														inverted:   false,
-																													},
+																											},
+																										},
+																									},
+																								},
+																							},
+																							&notExpr{
+																								pos: position{line: 27, col: 24, offset: 2310},
+																								expr: &charClassMatcher{
+																									pos:        position{line: 27, col: 25, offset: 2311},
+																									val:        "[_a-zA-Z0-9]",
+																									chars:      []rune{'_'},
+																									ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																							},
+																							&notExpr{
+																								pos: position{line: 27, col: 38, offset: 2324},
+																								expr: &seqExpr{
+																									pos: position{line: 27, col: 41, offset: 2327},
+																									exprs: []any{
+																										&litMatcher{
+																											pos:        position{line: 27, col: 41, offset: 2327},
+																											val:        ".",
+																											ignoreCase: false,
+																											want:       "\".\"",
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 27, col: 45, offset: 2331},
+																											val:        "[_a-zA-Z0-9]",
+																											chars:      []rune{'_'},
+																											ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 31, col: 24, offset: 2659},
+																					run: (*parser).callonPrimary172,
+																					expr: &seqExpr{
+																						pos: position{line: 31, col: 24, offset: 2659},
+																						exprs: []any{
+																							&zeroOrOneExpr{
+																								pos: position{line: 31, col: 24, offset: 2659},
+																								expr: &litMatcher{
+																									pos:        position{line: 31, col: 24, offset: 2659},
+																									val:        "-",
+																									ignoreCase: false,
+																									want:       "\"-\"",
+																								},
+																							},
+																							&choiceExpr{
+																								pos: position{line: 30, col: 24, offset: 2596},
+																								alternatives: []any{
+																									&litMatcher{
+																										pos:        position{line: 30, col: 24, offset: 2596},
+																										val:        "0",
+																										ignoreCase: false,
+																										want:       "\"0\"",
+																									},
+																									&seqExpr{
+																										pos: position{line: 30, col: 30, offset: 2602},
+																										exprs: []any{
+																											&charClassMatcher{
+																												pos:        position{line: 41, col: 24, offset: 3604},
+																												val:        "[1-9]",
+																												ranges:     []rune{'1', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&zeroOrMoreExpr{
+																												pos: position{line: 30, col: 50, offset: 2622},
+																												expr: &charClassMatcher{
+																													pos:        position{line: 32, col: 24, offset: 2762},
+																													val:        "[0-9]",
+																													ranges:     []rune{'0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
 																												},
 																											},
 																										},
 																									},
 																								},
 																							},
+																							&zeroOrOneExpr{
+																								pos: position{line: 31, col: 37, offset: 2672},
+																								expr: &seqExpr{
+																									pos: position{line: 31, col: 39, offset: 2674},
+																									exprs: []any{
+																										&litMatcher{
+																											pos:        position{line: 31, col: 39, offset: 2674},
+																											val:        ".",
+																											ignoreCase: false,
+																											want:       "\".\"",
+																										},
+																										&oneOrMoreExpr{
+																											pos: position{line: 31, col: 43, offset: 2678},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																									},
+																								},
+																							},
 																						},
 																					},
 																				},
-																			},
-																			&labeledExpr{
-																				pos:   position{line: 32, col: 40, offset: 2000},
-																				label: "tail",
-																				expr: &zeroOrMoreExpr{
-																					pos: position{line: 32, col: 45, offset: 2005},
+																				&actionExpr{
+																					pos: position{line: 28, col: 24, offset: 2369},
+																					run: (*parser).callonPrimary187,
 																					expr: &seqExpr{
-																						pos: position{line: 32, col: 46, offset: 2006},
+																						pos: position{line: 28, col: 24, offset: 2369},
 																						exprs: []any{
-																							&zeroOrMoreExpr{
-																								pos: position{line: 33, col: 24, offset: 2101},
+																							&choiceExpr{
+																								pos: position{line: 28, col: 26, offset: 2371},
+																								alternatives: []any{
+																									&litMatcher{
+																										pos:        position{line: 28, col: 26, offset: 2371},
+																										val:        "true",
+																										ignoreCase: false,
+																										want:       "\"true\"",
+																									},
+																									&litMatcher{
+																										pos:        position{line: 28, col: 35, offset: 2380},
+																										val:        "TRUE",
+																										ignoreCase: false,
+																										want:       "\"TRUE\"",
+																									},
+																									&litMatcher{
+																										pos:        position{line: 28, col: 44, offset: 2389},
+																										val:        "false",
+																										ignoreCase: false,
+																										want:       "\"false\"",
+																									},
+																									&litMatcher{
+																										pos:        position{line: 28, col: 54, offset: 2399},
+																										val:        "FALSE",
+																										ignoreCase: false,
+																										want:       "\"FALSE\"",
+																									},
+																								},
+																							},
+																							&notExpr{
+																								pos: position{line: 27, col: 24, offset: 2310},
 																								expr: &charClassMatcher{
-																									pos:        position{line: 33, col: 24, offset: 2101},
-																									val:        "[ \\t\\r\\n]",
-																									chars:      []rune{' ', '\t', '\r', '\n'},
+																									pos:        position{line: 27, col: 25, offset: 2311},
+																									val:        "[_a-zA-Z0-9]",
+																									chars:      []rune{'_'},
+																									ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
 																									ignoreCase: false,
 																									inverted:   false,
 																								},
 																							},
-																							&litMatcher{
-																								pos:        position{line: 32, col: 48, offset: 2008},
-																								val:        ",",
-																								ignoreCase: false,
-																								want:       "\",\"",
+																							&notExpr{
+																								pos: position{line: 27, col: 38, offset: 2324},
+																								expr: &seqExpr{
+																									pos: position{line: 27, col: 41, offset: 2327},
+																									exprs: []any{
+																										&litMatcher{
+																											pos:        position{line: 27, col: 41, offset: 2327},
+																											val:        ".",
+																											ignoreCase: false,
+																											want:       "\".\"",
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 27, col: 45, offset: 2331},
+																											val:        "[_a-zA-Z0-9]",
+																											chars:      []rune{'_'},
+																											ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																									},
+																								},
 																							},
-																							&zeroOrMoreExpr{
-																								pos: position{line: 33, col: 24, offset: 2101},
-																								expr: &charClassMatcher{
-																									pos:        position{line: 33, col: 24, offset: 2101},
-																									val:        "[ \\t\\r\\n]",
-																									chars:      []rune{' ', '\t', '\r', '\n'},
+																						},
+																					},
+																				},
+																				&actionExpr{
+																					pos: position{line: 24, col: 24, offset: 1998},
+																					run: (*parser).callonPrimary200,
+																					expr: &actionExpr{
+																						pos: position{line: 25, col: 24, offset: 2152},
+																						run: (*parser).callonPrimary201,
+																						expr: &seqExpr{
+																							pos: position{line: 25, col: 24, offset: 2152},
+																							exprs: []any{
+																								&charClassMatcher{
+																									pos:        position{line: 26, col: 24, offset: 2264},
+																									val:        "[_a-zA-Z]",
+																									chars:      []rune{'_'},
+																									ranges:     []rune{'a', 'z', 'A', 'Z'},
 																									ignoreCase: false,
 																									inverted:   false,
 																								},
-																							},
-																							&choiceExpr{
-																								pos: position{line: 16, col: 24, offset: 957},
-																								alternatives: []any{
-																									&actionExpr{
-																										pos: position{line: 23, col: 24, offset: 1391},
-																										run: (*parser).callonPrimary98,
-																										expr: &seqExpr{
-																											pos: position{line: 23, col: 24, offset: 1391},
-																											exprs: []any{
-																												&litMatcher{
-																													pos:        position{line: 23, col: 24, offset: 1391},
-																													val:        "\"",
+																								&zeroOrMoreExpr{
+																									pos: position{line: 26, col: 33, offset: 2273},
+																									expr: &charClassMatcher{
+																										pos:        position{line: 26, col: 33, offset: 2273},
+																										val:        "[_a-zA-Z0-9]",
+																										chars:      []rune{'_'},
+																										ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																								&zeroOrMoreExpr{
+																									pos: position{line: 25, col: 37, offset: 2165},
+																									expr: &seqExpr{
+																										pos: position{line: 25, col: 38, offset: 2166},
+																										exprs: []any{
+																											&litMatcher{
+																												pos:        position{line: 25, col: 38, offset: 2166},
+																												val:        ".",
+																												ignoreCase: false,
+																												want:       "\".\"",
+																											},
+																											&charClassMatcher{
+																												pos:        position{line: 26, col: 24, offset: 2264},
+																												val:        "[_a-zA-Z]",
+																												chars:      []rune{'_'},
+																												ranges:     []rune{'a', 'z', 'A', 'Z'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&zeroOrMoreExpr{
+																												pos: position{line: 26, col: 33, offset: 2273},
+																												expr: &charClassMatcher{
+																													pos:        position{line: 26, col: 33, offset: 2273},
+																													val:        "[_a-zA-Z0-9]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
 																													ignoreCase: false,
-																													want:       "\"\\\"\"",
+																													inverted:   false,
 																												},
-																												&zeroOrMoreExpr{
-																													pos: position{line: 24, col: 24, offset: 1494},
-																													expr: &choiceExpr{
-																														pos: position{line: 24, col: 26, offset: 1496},
-																														alternatives: []any{
-																															&seqExpr{
-																																pos: position{line: 24, col: 26, offset: 1496},
-																																exprs: []any{
-																																	&notExpr{
-																																		pos: position{line: 24, col: 26, offset: 1496},
-																																		expr: &charClassMatcher{
-																																			pos:        position{line: 25, col: 24, offset: 1559},
-																																			val:        "[\"\\\\\\x00-\\x1f]",
-																																			chars:      []rune{'"', '\\'},
-																																			ranges:     []rune{'\x00', '\x1f'},
-																																			ignoreCase: false,
-																																			inverted:   false,
-																																		},
-																																	},
-																																	&anyMatcher{
-																																		line: 24, col: 39, offset: 1509,
-																																	},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&labeledExpr{
+																		pos:   position{line: 56, col: 40, offset: 4704},
+																		label: "tail",
+																		expr: &zeroOrMoreExpr{
+																			pos: position{line: 56, col: 45, offset: 4709},
+																			expr: &seqExpr{
+																				pos: position{line: 56, col: 46, offset: 4710},
+																				exprs: []any{
+																					&zeroOrMoreExpr{
+																						pos: position{line: 65, col: 24, offset: 5441},
+																						expr: &charClassMatcher{
+																							pos:        position{line: 65, col: 24, offset: 5441},
+																							val:        "[ \\t\\r\\n]",
+																							chars:      []rune{' ', '\t', '\r', '\n'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																					&litMatcher{
+																						pos:        position{line: 56, col: 48, offset: 4712},
+																						val:        ",",
+																						ignoreCase: false,
+																						want:       "\",\"",
+																					},
+																					&zeroOrMoreExpr{
+																						pos: position{line: 65, col: 24, offset: 5441},
+																						expr: &charClassMatcher{
+																							pos:        position{line: 65, col: 24, offset: 5441},
+																							val:        "[ \\t\\r\\n]",
+																							chars:      []rune{' ', '\t', '\r', '\n'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																					&choiceExpr{
+																						pos: position{line: 23, col: 24, offset: 1899},
+																						alternatives: []any{
+																							&actionExpr{
+																								pos: position{line: 45, col: 24, offset: 3927},
+																								run: (*parser).callonPrimary221,
+																								expr: &seqExpr{
+																									pos: position{line: 45, col: 24, offset: 3927},
+																									exprs: []any{
+																										&litMatcher{
+																											pos:        position{line: 45, col: 24, offset: 3927},
+																											val:        "\"",
+																											ignoreCase: false,
+																											want:       "\"\\\"\"",
+																										},
+																										&zeroOrMoreExpr{
+																											pos: position{line: 46, col: 24, offset: 4030},
+																											expr: &choiceExpr{
+																												pos: position{line: 46, col: 26, offset: 4032},
+																												alternatives: []any{
+																													&seqExpr{
+																														pos: position{line: 46, col: 26, offset: 4032},
+																														exprs: []any{
+																															&notExpr{
+																																pos: position{line: 46, col: 26, offset: 4032},
+																																expr: &charClassMatcher{
+																																	pos:        position{line: 47, col: 24, offset: 4095},
+																																	val:        "[\"\\\\\\x00-\\x1f]",
+																																	chars:      []rune{'"', '\\'},
+																																	ranges:     []rune{'\x00', '\x1f'},
+																																	ignoreCase: false,
+																																	inverted:   false,
 																																},
 																															},
-																															&seqExpr{
-																																pos: position{line: 24, col: 43, offset: 1513},
-																																exprs: []any{
-																																	&litMatcher{
-																																		pos:        position{line: 24, col: 43, offset: 1513},
-																																		val:        "\\",
+																															&anyMatcher{
+																																line: 46, col: 39, offset: 4045,
+																															},
+																														},
+																													},
+																													&seqExpr{
+																														pos: position{line: 46, col: 43, offset: 4049},
+																														exprs: []any{
+																															&litMatcher{
+																																pos:        position{line: 46, col: 43, offset: 4049},
+																																val:        "\\",
+																																ignoreCase: false,
+																																want:       "\"\\\\\"",
+																															},
+																															&choiceExpr{
+																																pos: position{line: 48, col: 24, offset: 4133},
+																																alternatives: []any{
+																																	&charClassMatcher{
+																																		pos:        position{line: 49, col: 24, offset: 4189},
+																																		val:        "[\"\\\\/bfnrt]",
+																																		chars:      []rune{'"', '\\', '/', 'b', 'f', 'n', 'r', 't'},
 																																		ignoreCase: false,
-																																		want:       "\"\\\\\"",
+																																		inverted:   false,
 																																	},
-																																	&choiceExpr{
-																																		pos: position{line: 26, col: 24, offset: 1597},
-																																		alternatives: []any{
-																																			&charClassMatcher{
-																																				pos:        position{line: 27, col: 24, offset: 1653},
-																																				val:        "[\"\\\\/bfnrt]",
-																																				chars:      []rune{'"', '\\', '/', 'b', 'f', 'n', 'r', 't'},
+																																	&seqExpr{
+																																		pos: position{line: 50, col: 24, offset: 4224},
+																																		exprs: []any{
+																																			&litMatcher{
+																																				pos:        position{line: 50, col: 24, offset: 4224},
+																																				val:        "u",
 																																				ignoreCase: false,
+																																				want:       "\"u\"",
+																																			},
+																																			&charClassMatcher{
+																																				pos:        position{line: 51, col: 24, offset: 4287},
+																																				val:        "[0-9a-f]i",
+																																				ranges:     []rune{'0', '9', 'a', 'f'},
+																																				ignoreCase: true,
 																																				inverted:   false,
 																																			},
-																																			&seqExpr{
-																																				pos: position{line: 28, col: 24, offset: 1688},
-																																				exprs: []any{
-																																					&litMatcher{
-																																						pos:        position{line: 28, col: 24, offset: 1688},
-																																						val:        "u",
-																																						ignoreCase: false,
-																																						want:       "\"u\"",
-																																					},
-																																					&charClassMatcher{
-																																						pos:        position{line: 29, col: 24, offset: 1751},
-																																						val:        "[0-9a-f]i",
-																																						ranges:     []rune{'0', '9', 'a', 'f'},
-																																						ignoreCase: true,
-																																						inverted:   false,
-																																					},
-																																					&charClassMatcher{
-																																						pos:        position{line: 29, col: 24, offset: 1751},
-																																						val:        "[0-9a-f]i",
-																																						ranges:     []rune{'0', '9', 'a', 'f'},
-																																						ignoreCase: true,
-																																						inverted:   false,
-																																					},
-																																					&charClassMatcher{
-																																						pos:        position{line: 29, col: 24, offset: 1751},
-																																						val:        "[0-9a-f]i",
-																																						ranges:     []rune{'0', '9', 'a', 'f'},
-																																						ignoreCase: true,
-																																						inverted:   false,
-																																					},
-																																					&charClassMatcher{
-																																						pos:        position{line: 29, col: 24, offset: 1751},
-																																						val:        "[0-9a-f]i",
-																																						ranges:     []rune{'0', '9', 'a', 'f'},
-																																						ignoreCase: true,
-																																						inverted:   false,
-																																					},
-																																				},
+																																			&charClassMatcher{
+																																				pos:        position{line: 51, col: 24, offset: 4287},
+																																				val:        "[0-9a-f]i",
+																																				ranges:     []rune{'0', '9', 'a', 'f'},
+																																				ignoreCase: true,
+																																				inverted:   false,
+																																			},
+																																			&charClassMatcher{
+																																				pos:        position{line: 51, col: 24, offset: 4287},
+																																				val:        "[0-9a-f]i",
+																																				ranges:     []rune{'0', '9', 'a', 'f'},
+																																				ignoreCase: true,
+																																				inverted:   false,
+																																			},
+																																			&charClassMatcher{
+																																				pos:        position{line: 51, col: 24, offset: 4287},
+																																				val:        "[0-9a-f]i",
+																																				ranges:     []rune{'0', '9', 'a', 'f'},
+																																				ignoreCase: true,
+																																				inverted:   false,
 																																			},
 																																		},
 																																	},
@@ -853,53 +1678,172 @@ var g = &grammar{
 																														},
 																													},
 																												},
-																												&litMatcher{
-																													pos:        position{line: 23, col: 40, offset: 1407},
-																													val:        "\"",
-																													ignoreCase: false,
-																													want:       "\"\\\"\"",
-																												},
 																											},
 																										},
+																										&litMatcher{
+																											pos:        position{line: 45, col: 40, offset: 3943},
+																											val:        "\"",
+																											ignoreCase: false,
+																											want:       "\"\\\"\"",
+																										},
 																									},
-																									&actionExpr{
-																										pos: position{line: 20, col: 24, offset: 1230},
-																										run: (*parser).callonPrimary118,
-																										expr: &seqExpr{
-																											pos: position{line: 20, col: 24, offset: 1230},
-																											exprs: []any{
-																												&zeroOrOneExpr{
-																													pos: position{line: 20, col: 24, offset: 1230},
-																													expr: &litMatcher{
-																														pos:        position{line: 20, col: 24, offset: 1230},
-																														val:        "-",
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 33, col: 24, offset: 2791},
+																								run: (*parser).callonPrimary241,
+																								expr: &seqExpr{
+																									pos: position{line: 33, col: 24, offset: 2791},
+																									exprs: []any{
+																										&charClassMatcher{
+																											pos:        position{line: 32, col: 24, offset: 2762},
+																											val:        "[0-9]",
+																											ranges:     []rune{'0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 32, col: 24, offset: 2762},
+																											val:        "[0-9]",
+																											ranges:     []rune{'0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 32, col: 24, offset: 2762},
+																											val:        "[0-9]",
+																											ranges:     []rune{'0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 32, col: 24, offset: 2762},
+																											val:        "[0-9]",
+																											ranges:     []rune{'0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																										&litMatcher{
+																											pos:        position{line: 34, col: 76, offset: 2948},
+																											val:        "-",
+																											ignoreCase: false,
+																											want:       "\"-\"",
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 32, col: 24, offset: 2762},
+																											val:        "[0-9]",
+																											ranges:     []rune{'0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 32, col: 24, offset: 2762},
+																											val:        "[0-9]",
+																											ranges:     []rune{'0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																										&litMatcher{
+																											pos:        position{line: 34, col: 106, offset: 2978},
+																											val:        "-",
+																											ignoreCase: false,
+																											want:       "\"-\"",
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 32, col: 24, offset: 2762},
+																											val:        "[0-9]",
+																											ranges:     []rune{'0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																										&charClassMatcher{
+																											pos:        position{line: 32, col: 24, offset: 2762},
+																											val:        "[0-9]",
+																											ranges:     []rune{'0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																										&zeroOrOneExpr{
+																											pos: position{line: 33, col: 29, offset: 2796},
+																											expr: &seqExpr{
+																												pos: position{line: 33, col: 31, offset: 2798},
+																												exprs: []any{
+																													&charClassMatcher{
+																														pos:        position{line: 33, col: 31, offset: 2798},
+																														val:        "[Tt]",
+																														chars:      []rune{'T', 't'},
 																														ignoreCase: false,
-																														want:       "\"-\"",
+																														inverted:   false,
 																													},
-																												},
-																												&choiceExpr{
-																													pos: position{line: 19, col: 24, offset: 1167},
-																													alternatives: []any{
-																														&litMatcher{
-																															pos:        position{line: 19, col: 24, offset: 1167},
-																															val:        "0",
-																															ignoreCase: false,
-																															want:       "\"0\"",
-																														},
-																														&seqExpr{
-																															pos: position{line: 19, col: 30, offset: 1173},
+																													&charClassMatcher{
+																														pos:        position{line: 32, col: 24, offset: 2762},
+																														val:        "[0-9]",
+																														ranges:     []rune{'0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																													&charClassMatcher{
+																														pos:        position{line: 32, col: 24, offset: 2762},
+																														val:        "[0-9]",
+																														ranges:     []rune{'0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																													&litMatcher{
+																														pos:        position{line: 35, col: 50, offset: 3057},
+																														val:        ":",
+																														ignoreCase: false,
+																														want:       "\":\"",
+																													},
+																													&charClassMatcher{
+																														pos:        position{line: 32, col: 24, offset: 2762},
+																														val:        "[0-9]",
+																														ranges:     []rune{'0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																													&charClassMatcher{
+																														pos:        position{line: 32, col: 24, offset: 2762},
+																														val:        "[0-9]",
+																														ranges:     []rune{'0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																													&litMatcher{
+																														pos:        position{line: 35, col: 80, offset: 3087},
+																														val:        ":",
+																														ignoreCase: false,
+																														want:       "\":\"",
+																													},
+																													&charClassMatcher{
+																														pos:        position{line: 32, col: 24, offset: 2762},
+																														val:        "[0-9]",
+																														ranges:     []rune{'0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																													&charClassMatcher{
+																														pos:        position{line: 32, col: 24, offset: 2762},
+																														val:        "[0-9]",
+																														ranges:     []rune{'0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																													&zeroOrOneExpr{
+																														pos: position{line: 35, col: 110, offset: 3117},
+																														expr: &seqExpr{
+																															pos: position{line: 35, col: 112, offset: 3119},
 																															exprs: []any{
-																																&charClassMatcher{
-																																	pos:        position{line: 22, col: 24, offset: 1362},
-																																	val:        "[1-9]",
-																																	ranges:     []rune{'1', '9'},
+																																&litMatcher{
+																																	pos:        position{line: 35, col: 112, offset: 3119},
+																																	val:        ".",
 																																	ignoreCase: false,
-																																	inverted:   false,
+																																	want:       "\".\"",
 																																},
-																																&zeroOrMoreExpr{
-																																	pos: position{line: 19, col: 50, offset: 1193},
+																																&oneOrMoreExpr{
+																																	pos: position{line: 35, col: 116, offset: 3123},
 																																	expr: &charClassMatcher{
-																																		pos:        position{line: 21, col: 24, offset: 1333},
+																																		pos:        position{line: 32, col: 24, offset: 2762},
 																																		val:        "[0-9]",
 																																		ranges:     []rune{'0', '9'},
 																																		ignoreCase: false,
@@ -909,26 +1853,60 @@ var g = &grammar{
 																															},
 																														},
 																													},
-																												},
-																												&zeroOrOneExpr{
-																													pos: position{line: 20, col: 37, offset: 1243},
-																													expr: &seqExpr{
-																														pos: position{line: 20, col: 39, offset: 1245},
-																														exprs: []any{
-																															&litMatcher{
-																																pos:        position{line: 20, col: 39, offset: 1245},
-																																val:        ".",
+																													&choiceExpr{
+																														pos: position{line: 36, col: 24, offset: 3172},
+																														alternatives: []any{
+																															&charClassMatcher{
+																																pos:        position{line: 36, col: 24, offset: 3172},
+																																val:        "[Zz]",
+																																chars:      []rune{'Z', 'z'},
 																																ignoreCase: false,
-																																want:       "\".\"",
+																																inverted:   false,
 																															},
-																															&oneOrMoreExpr{
-																																pos: position{line: 20, col: 43, offset: 1249},
-																																expr: &charClassMatcher{
-																																	pos:        position{line: 21, col: 24, offset: 1333},
-																																	val:        "[0-9]",
-																																	ranges:     []rune{'0', '9'},
-																																	ignoreCase: false,
-																																	inverted:   false,
+																															&seqExpr{
+																																pos: position{line: 36, col: 31, offset: 3179},
+																																exprs: []any{
+																																	&charClassMatcher{
+																																		pos:        position{line: 36, col: 31, offset: 3179},
+																																		val:        "[+-]",
+																																		chars:      []rune{'+', '-'},
+																																		ignoreCase: false,
+																																		inverted:   false,
+																																	},
+																																	&charClassMatcher{
+																																		pos:        position{line: 32, col: 24, offset: 2762},
+																																		val:        "[0-9]",
+																																		ranges:     []rune{'0', '9'},
+																																		ignoreCase: false,
+																																		inverted:   false,
+																																	},
+																																	&charClassMatcher{
+																																		pos:        position{line: 32, col: 24, offset: 2762},
+																																		val:        "[0-9]",
+																																		ranges:     []rune{'0', '9'},
+																																		ignoreCase: false,
+																																		inverted:   false,
+																																	},
+																																	&litMatcher{
+																																		pos:        position{line: 36, col: 62, offset: 3210},
+																																		val:        ":",
+																																		ignoreCase: false,
+																																		want:       "\":\"",
+																																	},
+																																	&charClassMatcher{
+																																		pos:        position{line: 32, col: 24, offset: 2762},
+																																		val:        "[0-9]",
+																																		ranges:     []rune{'0', '9'},
+																																		ignoreCase: false,
+																																		inverted:   false,
+																																	},
+																																	&charClassMatcher{
+																																		pos:        position{line: 32, col: 24, offset: 2762},
+																																		val:        "[0-9]",
+																																		ranges:     []rune{'0', '9'},
+																																		ignoreCase: false,
+																																		inverted:   false,
+																																	},
 																																},
 																															},
 																														},
@@ -936,25 +1914,141 @@ var g = &grammar{
 																												},
 																											},
 																										},
+																										&notExpr{
+																											pos: position{line: 27, col: 24, offset: 2310},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 27, col: 25, offset: 2311},
+																												val:        "[_a-zA-Z0-9]",
+																												chars:      []rune{'_'},
+																												ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																										&notExpr{
+																											pos: position{line: 27, col: 38, offset: 2324},
+																											expr: &seqExpr{
+																												pos: position{line: 27, col: 41, offset: 2327},
+																												exprs: []any{
+																													&litMatcher{
+																														pos:        position{line: 27, col: 41, offset: 2327},
+																														val:        ".",
+																														ignoreCase: false,
+																														want:       "\".\"",
+																													},
+																													&charClassMatcher{
+																														pos:        position{line: 27, col: 45, offset: 2331},
+																														val:        "[_a-zA-Z0-9]",
+																														chars:      []rune{'_'},
+																														ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																												},
+																											},
+																										},
 																									},
-																									&actionExpr{
-																										pos: position{line: 17, col: 24, offset: 1009},
-																										run: (*parser).callonPrimary133,
-																										expr: &seqExpr{
-																											pos: position{line: 17, col: 24, offset: 1009},
-																											exprs: []any{
-																												&charClassMatcher{
-																													pos:        position{line: 18, col: 24, offset: 1121},
-																													val:        "[_a-zA-Z]",
-																													chars:      []rune{'_'},
-																													ranges:     []rune{'a', 'z', 'A', 'Z'},
-																													ignoreCase: false,
-																													inverted:   false,
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 37, col: 24, offset: 3263},
+																								run: (*parser).callonPrimary284,
+																								expr: &seqExpr{
+																									pos: position{line: 37, col: 24, offset: 3263},
+																									exprs: []any{
+																										&litMatcher{
+																											pos:        position{line: 37, col: 24, offset: 3263},
+																											val:        "now",
+																											ignoreCase: false,
+																											want:       "\"now\"",
+																										},
+																										&zeroOrOneExpr{
+																											pos: position{line: 37, col: 30, offset: 3269},
+																											expr: &seqExpr{
+																												pos: position{line: 37, col: 32, offset: 3271},
+																												exprs: []any{
+																													&charClassMatcher{
+																														pos:        position{line: 37, col: 32, offset: 3271},
+																														val:        "[+-]",
+																														chars:      []rune{'+', '-'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																													&oneOrMoreExpr{
+																														pos: position{line: 39, col: 24, offset: 3477},
+																														expr: &seqExpr{
+																															pos: position{line: 39, col: 26, offset: 3479},
+																															exprs: []any{
+																																&oneOrMoreExpr{
+																																	pos: position{line: 39, col: 26, offset: 3479},
+																																	expr: &charClassMatcher{
+																																		pos:        position{line: 32, col: 24, offset: 2762},
+																																		val:        "[0-9]",
+																																		ranges:     []rune{'0', '9'},
+																																		ignoreCase: false,
+																																		inverted:   false,
+																																	},
+																																},
+																																&choiceExpr{
+																																	pos: position{line: 40, col: 24, offset: 3532},
+																																	alternatives: []any{
+																																		&litMatcher{
+																																			pos:        position{line: 40, col: 24, offset: 3532},
+																																			val:        "ns",
+																																			ignoreCase: false,
+																																			want:       "\"ns\"",
+																																		},
+																																		&litMatcher{
+																																			pos:        position{line: 40, col: 31, offset: 3539},
+																																			val:        "us",
+																																			ignoreCase: false,
+																																			want:       "\"us\"",
+																																		},
+																																		&litMatcher{
+																																			pos:        position{line: 40, col: 38, offset: 3546},
+																																			val:        "ms",
+																																			ignoreCase: false,
+																																			want:       "\"ms\"",
+																																		},
+																																		&charClassMatcher{
+																																			pos:        position{line: 40, col: 45, offset: 3553},
+																																			val:        "[smhdw]",
+																																			chars:      []rune{'s', 'm', 'h', 'd', 'w'},
+																																			ignoreCase: false,
+																																			inverted:   false,
+																																		},
+																																	},
+																																},
+																															},
+																														},
+																													},
 																												},
-																												&zeroOrMoreExpr{
-																													pos: position{line: 18, col: 33, offset: 1130},
-																													expr: &charClassMatcher{
-																														pos:        position{line: 18, col: 33, offset: 1130},
+																											},
+																										},
+																										&notExpr{
+																											pos: position{line: 27, col: 24, offset: 2310},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 27, col: 25, offset: 2311},
+																												val:        "[_a-zA-Z0-9]",
+																												chars:      []rune{'_'},
+																												ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																										&notExpr{
+																											pos: position{line: 27, col: 38, offset: 2324},
+																											expr: &seqExpr{
+																												pos: position{line: 27, col: 41, offset: 2327},
+																												exprs: []any{
+																													&litMatcher{
+																														pos:        position{line: 27, col: 41, offset: 2327},
+																														val:        ".",
+																														ignoreCase: false,
+																														want:       "\".\"",
+																													},
+																													&charClassMatcher{
+																														pos:        position{line: 27, col: 45, offset: 2331},
 																														val:        "[_a-zA-Z0-9]",
 																														chars:      []rune{'_'},
 																														ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -962,35 +2056,312 @@ var g = &grammar{
 																														inverted:   false,
 																													},
 																												},
-																												&zeroOrMoreExpr{
-																													pos: position{line: 17, col: 37, offset: 1022},
-																													expr: &seqExpr{
-																														pos: position{line: 17, col: 38, offset: 1023},
-																														exprs: []any{
+																											},
+																										},
+																									},
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 38, col: 24, offset: 3372},
+																								run: (*parser).callonPrimary305,
+																								expr: &seqExpr{
+																									pos: position{line: 38, col: 24, offset: 3372},
+																									exprs: []any{
+																										&zeroOrOneExpr{
+																											pos: position{line: 38, col: 24, offset: 3372},
+																											expr: &litMatcher{
+																												pos:        position{line: 38, col: 24, offset: 3372},
+																												val:        "-",
+																												ignoreCase: false,
+																												want:       "\"-\"",
+																											},
+																										},
+																										&oneOrMoreExpr{
+																											pos: position{line: 39, col: 24, offset: 3477},
+																											expr: &seqExpr{
+																												pos: position{line: 39, col: 26, offset: 3479},
+																												exprs: []any{
+																													&oneOrMoreExpr{
+																														pos: position{line: 39, col: 26, offset: 3479},
+																														expr: &charClassMatcher{
+																															pos:        position{line: 32, col: 24, offset: 2762},
+																															val:        "[0-9]",
+																															ranges:     []rune{'0', '9'},
+																															ignoreCase: false,
+																															inverted:   false,
+																														},
+																													},
+																													&choiceExpr{
+																														pos: position{line: 40, col: 24, offset: 3532},
+																														alternatives: []any{
 																															&litMatcher{
-																																pos:        position{line: 17, col: 38, offset: 1023},
-																																val:        ".",
+																																pos:        position{line: 40, col: 24, offset: 3532},
+																																val:        "ns",
 																																ignoreCase: false,
-																																want:       "\".\"",
+																																want:       "\"ns\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 40, col: 31, offset: 3539},
+																																val:        "us",
+																																ignoreCase: false,
+																																want:       "\"us\"",
+																															},
+																															&litMatcher{
+																																pos:        position{line: 40, col: 38, offset: 3546},
+																																val:        "ms",
+																																ignoreCase: false,
+																																want:       "\"ms\"",
 																															},
 																															&charClassMatcher{
-																																pos:        position{line: 18, col: 24, offset: 1121},
-																																val:        "[_a-zA-Z]",
-																																chars:      []rune{'_'},
-																																ranges:     []rune{'a', 'z', 'A', 'Z'},
+																																pos:        position{line: 40, col: 45, offset: 3553},
+																																val:        "[smhdw]",
+																																chars:      []rune{'s', 'm', 'h', 'd', 'w'},
 																																ignoreCase: false,
 																																inverted:   false,
 																															},
-																															&zeroOrMoreExpr{
-																																pos: position{line: 18, col: 33, offset: 1130},
-																																expr: &charClassMatcher{
-																																	pos:        position{line: 18, col: 33, offset: 1130},
-																																	val:        "[_a-zA-Z0-9]",
-																																	chars:      []rune{'_'},
-																																	ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
-																																	ignoreCase: false,
-																																	inverted:   false,
-																																},
+																														},
+																													},
+																												},
+																											},
+																										},
+																										&notExpr{
+																											pos: position{line: 27, col: 24, offset: 2310},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 27, col: 25, offset: 2311},
+																												val:        "[_a-zA-Z0-9]",
+																												chars:      []rune{'_'},
+																												ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																										&notExpr{
+																											pos: position{line: 27, col: 38, offset: 2324},
+																											expr: &seqExpr{
+																												pos: position{line: 27, col: 41, offset: 2327},
+																												exprs: []any{
+																													&litMatcher{
+																														pos:        position{line: 27, col: 41, offset: 2327},
+																														val:        ".",
+																														ignoreCase: false,
+																														want:       "\".\"",
+																													},
+																													&charClassMatcher{
+																														pos:        position{line: 27, col: 45, offset: 2331},
+																														val:        "[_a-zA-Z0-9]",
+																														chars:      []rune{'_'},
+																														ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 31, col: 24, offset: 2659},
+																								run: (*parser).callonPrimary324,
+																								expr: &seqExpr{
+																									pos: position{line: 31, col: 24, offset: 2659},
+																									exprs: []any{
+																										&zeroOrOneExpr{
+																											pos: position{line: 31, col: 24, offset: 2659},
+																											expr: &litMatcher{
+																												pos:        position{line: 31, col: 24, offset: 2659},
+																												val:        "-",
+																												ignoreCase: false,
+																												want:       "\"-\"",
+																											},
+																										},
+																										&choiceExpr{
+																											pos: position{line: 30, col: 24, offset: 2596},
+																											alternatives: []any{
+																												&litMatcher{
+																													pos:        position{line: 30, col: 24, offset: 2596},
+																													val:        "0",
+																													ignoreCase: false,
+																													want:       "\"0\"",
+																												},
+																												&seqExpr{
+																													pos: position{line: 30, col: 30, offset: 2602},
+																													exprs: []any{
+																														&charClassMatcher{
+																															pos:        position{line: 41, col: 24, offset: 3604},
+																															val:        "[1-9]",
+																															ranges:     []rune{'1', '9'},
+																															ignoreCase: false,
+																															inverted:   false,
+																														},
+																														&zeroOrMoreExpr{
+																															pos: position{line: 30, col: 50, offset: 2622},
+																															expr: &charClassMatcher{
+																																pos:        position{line: 32, col: 24, offset: 2762},
+																																val:        "[0-9]",
+																																ranges:     []rune{'0', '9'},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																														},
+																													},
+																												},
+																											},
+																										},
+																										&zeroOrOneExpr{
+																											pos: position{line: 31, col: 37, offset: 2672},
+																											expr: &seqExpr{
+																												pos: position{line: 31, col: 39, offset: 2674},
+																												exprs: []any{
+																													&litMatcher{
+																														pos:        position{line: 31, col: 39, offset: 2674},
+																														val:        ".",
+																														ignoreCase: false,
+																														want:       "\".\"",
+																													},
+																													&oneOrMoreExpr{
+																														pos: position{line: 31, col: 43, offset: 2678},
+																														expr: &charClassMatcher{
+																															pos:        position{line: 32, col: 24, offset: 2762},
+																															val:        "[0-9]",
+																															ranges:     []rune{'0', '9'},
+																															ignoreCase: false,
+																															inverted:   false,
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 28, col: 24, offset: 2369},
+																								run: (*parser).callonPrimary339,
+																								expr: &seqExpr{
+																									pos: position{line: 28, col: 24, offset: 2369},
+																									exprs: []any{
+																										&choiceExpr{
+																											pos: position{line: 28, col: 26, offset: 2371},
+																											alternatives: []any{
+																												&litMatcher{
+																													pos:        position{line: 28, col: 26, offset: 2371},
+																													val:        "true",
+																													ignoreCase: false,
+																													want:       "\"true\"",
+																												},
+																												&litMatcher{
+																													pos:        position{line: 28, col: 35, offset: 2380},
+																													val:        "TRUE",
+																													ignoreCase: false,
+																													want:       "\"TRUE\"",
+																												},
+																												&litMatcher{
+																													pos:        position{line: 28, col: 44, offset: 2389},
+																													val:        "false",
+																													ignoreCase: false,
+																													want:       "\"false\"",
+																												},
+																												&litMatcher{
+																													pos:        position{line: 28, col: 54, offset: 2399},
+																													val:        "FALSE",
+																													ignoreCase: false,
+																													want:       "\"FALSE\"",
+																												},
+																											},
+																										},
+																										&notExpr{
+																											pos: position{line: 27, col: 24, offset: 2310},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 27, col: 25, offset: 2311},
+																												val:        "[_a-zA-Z0-9]",
+																												chars:      []rune{'_'},
+																												ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																										&notExpr{
+																											pos: position{line: 27, col: 38, offset: 2324},
+																											expr: &seqExpr{
+																												pos: position{line: 27, col: 41, offset: 2327},
+																												exprs: []any{
+																													&litMatcher{
+																														pos:        position{line: 27, col: 41, offset: 2327},
+																														val:        ".",
+																														ignoreCase: false,
+																														want:       "\".\"",
+																													},
+																													&charClassMatcher{
+																														pos:        position{line: 27, col: 45, offset: 2331},
+																														val:        "[_a-zA-Z0-9]",
+																														chars:      []rune{'_'},
+																														ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																							&actionExpr{
+																								pos: position{line: 24, col: 24, offset: 1998},
+																								run: (*parser).callonPrimary352,
+																								expr: &actionExpr{
+																									pos: position{line: 25, col: 24, offset: 2152},
+																									run: (*parser).callonPrimary353,
+																									expr: &seqExpr{
+																										pos: position{line: 25, col: 24, offset: 2152},
+																										exprs: []any{
+																											&charClassMatcher{
+																												pos:        position{line: 26, col: 24, offset: 2264},
+																												val:        "[_a-zA-Z]",
+																												chars:      []rune{'_'},
+																												ranges:     []rune{'a', 'z', 'A', 'Z'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&zeroOrMoreExpr{
+																												pos: position{line: 26, col: 33, offset: 2273},
+																												expr: &charClassMatcher{
+																													pos:        position{line: 26, col: 33, offset: 2273},
+																													val:        "[_a-zA-Z0-9]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																											&zeroOrMoreExpr{
+																												pos: position{line: 25, col: 37, offset: 2165},
+																												expr: &seqExpr{
+																													pos: position{line: 25, col: 38, offset: 2166},
+																													exprs: []any{
+																														&litMatcher{
+																															pos:        position{line: 25, col: 38, offset: 2166},
+																															val:        ".",
+																															ignoreCase: false,
+																															want:       "\".\"",
+																														},
+																														&charClassMatcher{
+																															pos:        position{line: 26, col: 24, offset: 2264},
+																															val:        "[_a-zA-Z]",
+																															chars:      []rune{'_'},
+																															ranges:     []rune{'a', 'z', 'A', 'Z'},
+																															ignoreCase: false,
+																															inverted:   false,
+																														},
+																														&zeroOrMoreExpr{
+																															pos: position{line: 26, col: 33, offset: 2273},
+																															expr: &charClassMatcher{
+																																pos:        position{line: 26, col: 33, offset: 2273},
+																																val:        "[_a-zA-Z0-9]",
+																																chars:      []rune{'_'},
+																																ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																																ignoreCase: false,
+																																inverted:   false,
 																															},
 																														},
 																													},
@@ -1009,49 +2380,5309 @@ var g = &grammar{
 																},
 															},
 														},
-														&zeroOrMoreExpr{
-															pos: position{line: 33, col: 24, offset: 2101},
+													},
+												},
+												&zeroOrMoreExpr{
+													pos: position{line: 65, col: 24, offset: 5441},
+													expr: &charClassMatcher{
+														pos:        position{line: 65, col: 24, offset: 5441},
+														val:        "[ \\t\\r\\n]",
+														chars:      []rune{' ', '\t', '\r', '\n'},
+														ignoreCase: false,
+														inverted:   false,
+													},
+												},
+												&litMatcher{
+													pos:        position{line: 55, col: 54, offset: 4598},
+													val:        "]",
+													ignoreCase: false,
+													want:       "\"]\"",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 17, col: 24, offset: 1253},
+						run: (*parser).callonPrimary367,
+						expr: &seqExpr{
+							pos: position{line: 17, col: 24, offset: 1253},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 17, col: 24, offset: 1253},
+									label: "field",
+									expr: &actionExpr{
+										pos: position{line: 25, col: 24, offset: 2152},
+										run: (*parser).callonPrimary370,
+										expr: &seqExpr{
+											pos: position{line: 25, col: 24, offset: 2152},
+											exprs: []any{
+												&charClassMatcher{
+													pos:        position{line: 26, col: 24, offset: 2264},
+													val:        "[_a-zA-Z]",
+													chars:      []rune{'_'},
+													ranges:     []rune{'a', 'z', 'A', 'Z'},
+													ignoreCase: false,
+													inverted:   false,
+												},
+												&zeroOrMoreExpr{
+													pos: position{line: 26, col: 33, offset: 2273},
+													expr: &charClassMatcher{
+														pos:        position{line: 26, col: 33, offset: 2273},
+														val:        "[_a-zA-Z0-9]",
+														chars:      []rune{'_'},
+														ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+														ignoreCase: false,
+														inverted:   false,
+													},
+												},
+												&zeroOrMoreExpr{
+													pos: position{line: 25, col: 37, offset: 2165},
+													expr: &seqExpr{
+														pos: position{line: 25, col: 38, offset: 2166},
+														exprs: []any{
+															&litMatcher{
+																pos:        position{line: 25, col: 38, offset: 2166},
+																val:        ".",
+																ignoreCase: false,
+																want:       "\".\"",
+															},
+															&charClassMatcher{
+																pos:        position{line: 26, col: 24, offset: 2264},
+																val:        "[_a-zA-Z]",
+																chars:      []rune{'_'},
+																ranges:     []rune{'a', 'z', 'A', 'Z'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+															&zeroOrMoreExpr{
+																pos: position{line: 26, col: 33, offset: 2273},
+																expr: &charClassMatcher{
+																	pos:        position{line: 26, col: 33, offset: 2273},
+																	val:        "[_a-zA-Z0-9]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 65, col: 24, offset: 5441},
+									expr: &charClassMatcher{
+										pos:        position{line: 65, col: 24, offset: 5441},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 17, col: 43, offset: 1272},
+									label: "op",
+									expr: &choiceExpr{
+										pos: position{line: 54, col: 26, offset: 4480},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 54, col: 26, offset: 4480},
+												val:        ">=",
+												ignoreCase: false,
+												want:       "\">=\"",
+											},
+											&litMatcher{
+												pos:        position{line: 54, col: 33, offset: 4487},
+												val:        ">",
+												ignoreCase: false,
+												want:       "\">\"",
+											},
+											&litMatcher{
+												pos:        position{line: 54, col: 39, offset: 4493},
+												val:        "<=",
+												ignoreCase: false,
+												want:       "\"<=\"",
+											},
+											&litMatcher{
+												pos:        position{line: 54, col: 46, offset: 4500},
+												val:        "<",
+												ignoreCase: false,
+												want:       "\"<\"",
+											},
+											&litMatcher{
+												pos:        position{line: 54, col: 52, offset: 4506},
+												val:        "!:",
+												ignoreCase: false,
+												want:       "\"!:\"",
+											},
+											&litMatcher{
+												pos:        position{line: 54, col: 59, offset: 4513},
+												val:        "!=",
+												ignoreCase: false,
+												want:       "\"!=\"",
+											},
+											&charClassMatcher{
+												pos:        position{line: 54, col: 66, offset: 4520},
+												val:        "[:=]",
+												chars:      []rune{':', '='},
+												ignoreCase: false,
+												inverted:   false,
+											},
+											&litMatcher{
+												pos:        position{line: 54, col: 78, offset: 4532},
+												val:        "~*",
+												ignoreCase: false,
+												want:       "\"~*\"",
+											},
+											&litMatcher{
+												pos:        position{line: 54, col: 85, offset: 4539},
+												val:        "~",
+												ignoreCase: false,
+												want:       "\"~\"",
+											},
+										},
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 65, col: 24, offset: 5441},
+									expr: &charClassMatcher{
+										pos:        position{line: 65, col: 24, offset: 5441},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 17, col: 54, offset: 1283},
+									label: "value",
+									expr: &choiceExpr{
+										pos: position{line: 21, col: 24, offset: 1729},
+										alternatives: []any{
+											&actionExpr{
+												pos: position{line: 57, col: 24, offset: 4805},
+												run: (*parser).callonPrimary398,
+												expr: &seqExpr{
+													pos: position{line: 57, col: 24, offset: 4805},
+													exprs: []any{
+														&labeledExpr{
+															pos:   position{line: 57, col: 24, offset: 4805},
+															label: "left",
+															expr: &charClassMatcher{
+																pos:        position{line: 60, col: 24, offset: 5176},
+																val:        "[[{]",
+																chars:      []rune{'[', '{'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+														&zeroOrMoreExpr{
+															pos: position{line: 65, col: 24, offset: 5441},
+															expr: &charClassMatcher{
+																pos:        position{line: 65, col: 24, offset: 5441},
+																val:        "[ \\t\\r\\n]",
+																chars:      []rune{' ', '\t', '\r', '\n'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+														&labeledExpr{
+															pos:   position{line: 57, col: 41, offset: 4822},
+															label: "low",
+															expr: &choiceExpr{
+																pos: position{line: 63, col: 24, offset: 5277},
+																alternatives: []any{
+																	&actionExpr{
+																		pos: position{line: 33, col: 24, offset: 2791},
+																		run: (*parser).callonPrimary406,
+																		expr: &seqExpr{
+																			pos: position{line: 33, col: 24, offset: 2791},
+																			exprs: []any{
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&litMatcher{
+																					pos:        position{line: 34, col: 76, offset: 2948},
+																					val:        "-",
+																					ignoreCase: false,
+																					want:       "\"-\"",
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&litMatcher{
+																					pos:        position{line: 34, col: 106, offset: 2978},
+																					val:        "-",
+																					ignoreCase: false,
+																					want:       "\"-\"",
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&zeroOrOneExpr{
+																					pos: position{line: 33, col: 29, offset: 2796},
+																					expr: &seqExpr{
+																						pos: position{line: 33, col: 31, offset: 2798},
+																						exprs: []any{
+																							&charClassMatcher{
+																								pos:        position{line: 33, col: 31, offset: 2798},
+																								val:        "[Tt]",
+																								chars:      []rune{'T', 't'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&litMatcher{
+																								pos:        position{line: 35, col: 50, offset: 3057},
+																								val:        ":",
+																								ignoreCase: false,
+																								want:       "\":\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&litMatcher{
+																								pos:        position{line: 35, col: 80, offset: 3087},
+																								val:        ":",
+																								ignoreCase: false,
+																								want:       "\":\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&zeroOrOneExpr{
+																								pos: position{line: 35, col: 110, offset: 3117},
+																								expr: &seqExpr{
+																									pos: position{line: 35, col: 112, offset: 3119},
+																									exprs: []any{
+																										&litMatcher{
+																											pos:        position{line: 35, col: 112, offset: 3119},
+																											val:        ".",
+																											ignoreCase: false,
+																											want:       "\".\"",
+																										},
+																										&oneOrMoreExpr{
+																											pos: position{line: 35, col: 116, offset: 3123},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																									},
+																								},
+																							},
+																							&choiceExpr{
+																								pos: position{line: 36, col: 24, offset: 3172},
+																								alternatives: []any{
+																									&charClassMatcher{
+																										pos:        position{line: 36, col: 24, offset: 3172},
+																										val:        "[Zz]",
+																										chars:      []rune{'Z', 'z'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																									&seqExpr{
+																										pos: position{line: 36, col: 31, offset: 3179},
+																										exprs: []any{
+																											&charClassMatcher{
+																												pos:        position{line: 36, col: 31, offset: 3179},
+																												val:        "[+-]",
+																												chars:      []rune{'+', '-'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&litMatcher{
+																												pos:        position{line: 36, col: 62, offset: 3210},
+																												val:        ":",
+																												ignoreCase: false,
+																												want:       "\":\"",
+																											},
+																											&charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 24, offset: 2310},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 27, col: 25, offset: 2311},
+																						val:        "[_a-zA-Z0-9]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 38, offset: 2324},
+																					expr: &seqExpr{
+																						pos: position{line: 27, col: 41, offset: 2327},
+																						exprs: []any{
+																							&litMatcher{
+																								pos:        position{line: 27, col: 41, offset: 2327},
+																								val:        ".",
+																								ignoreCase: false,
+																								want:       "\".\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 27, col: 45, offset: 2331},
+																								val:        "[_a-zA-Z0-9]",
+																								chars:      []rune{'_'},
+																								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 37, col: 24, offset: 3263},
+																		run: (*parser).callonPrimary449,
+																		expr: &seqExpr{
+																			pos: position{line: 37, col: 24, offset: 3263},
+																			exprs: []any{
+																				&litMatcher{
+																					pos:        position{line: 37, col: 24, offset: 3263},
+																					val:        "now",
+																					ignoreCase: false,
+																					want:       "\"now\"",
+																				},
+																				&zeroOrOneExpr{
+																					pos: position{line: 37, col: 30, offset: 3269},
+																					expr: &seqExpr{
+																						pos: position{line: 37, col: 32, offset: 3271},
+																						exprs: []any{
+																							&charClassMatcher{
+																								pos:        position{line: 37, col: 32, offset: 3271},
+																								val:        "[+-]",
+																								chars:      []rune{'+', '-'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&oneOrMoreExpr{
+																								pos: position{line: 39, col: 24, offset: 3477},
+																								expr: &seqExpr{
+																									pos: position{line: 39, col: 26, offset: 3479},
+																									exprs: []any{
+																										&oneOrMoreExpr{
+																											pos: position{line: 39, col: 26, offset: 3479},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																										&choiceExpr{
+																											pos: position{line: 40, col: 24, offset: 3532},
+																											alternatives: []any{
+																												&litMatcher{
+																													pos:        position{line: 40, col: 24, offset: 3532},
+																													val:        "ns",
+																													ignoreCase: false,
+																													want:       "\"ns\"",
+																												},
+																												&litMatcher{
+																													pos:        position{line: 40, col: 31, offset: 3539},
+																													val:        "us",
+																													ignoreCase: false,
+																													want:       "\"us\"",
+																												},
+																												&litMatcher{
+																													pos:        position{line: 40, col: 38, offset: 3546},
+																													val:        "ms",
+																													ignoreCase: false,
+																													want:       "\"ms\"",
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 40, col: 45, offset: 3553},
+																													val:        "[smhdw]",
+																													chars:      []rune{'s', 'm', 'h', 'd', 'w'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 24, offset: 2310},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 27, col: 25, offset: 2311},
+																						val:        "[_a-zA-Z0-9]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 38, offset: 2324},
+																					expr: &seqExpr{
+																						pos: position{line: 27, col: 41, offset: 2327},
+																						exprs: []any{
+																							&litMatcher{
+																								pos:        position{line: 27, col: 41, offset: 2327},
+																								val:        ".",
+																								ignoreCase: false,
+																								want:       "\".\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 27, col: 45, offset: 2331},
+																								val:        "[_a-zA-Z0-9]",
+																								chars:      []rune{'_'},
+																								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 38, col: 24, offset: 3372},
+																		run: (*parser).callonPrimary470,
+																		expr: &seqExpr{
+																			pos: position{line: 38, col: 24, offset: 3372},
+																			exprs: []any{
+																				&zeroOrOneExpr{
+																					pos: position{line: 38, col: 24, offset: 3372},
+																					expr: &litMatcher{
+																						pos:        position{line: 38, col: 24, offset: 3372},
+																						val:        "-",
+																						ignoreCase: false,
+																						want:       "\"-\"",
+																					},
+																				},
+																				&oneOrMoreExpr{
+																					pos: position{line: 39, col: 24, offset: 3477},
+																					expr: &seqExpr{
+																						pos: position{line: 39, col: 26, offset: 3479},
+																						exprs: []any{
+																							&oneOrMoreExpr{
+																								pos: position{line: 39, col: 26, offset: 3479},
+																								expr: &charClassMatcher{
+																									pos:        position{line: 32, col: 24, offset: 2762},
+																									val:        "[0-9]",
+																									ranges:     []rune{'0', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																							},
+																							&choiceExpr{
+																								pos: position{line: 40, col: 24, offset: 3532},
+																								alternatives: []any{
+																									&litMatcher{
+																										pos:        position{line: 40, col: 24, offset: 3532},
+																										val:        "ns",
+																										ignoreCase: false,
+																										want:       "\"ns\"",
+																									},
+																									&litMatcher{
+																										pos:        position{line: 40, col: 31, offset: 3539},
+																										val:        "us",
+																										ignoreCase: false,
+																										want:       "\"us\"",
+																									},
+																									&litMatcher{
+																										pos:        position{line: 40, col: 38, offset: 3546},
+																										val:        "ms",
+																										ignoreCase: false,
+																										want:       "\"ms\"",
+																									},
+																									&charClassMatcher{
+																										pos:        position{line: 40, col: 45, offset: 3553},
+																										val:        "[smhdw]",
+																										chars:      []rune{'s', 'm', 'h', 'd', 'w'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 24, offset: 2310},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 27, col: 25, offset: 2311},
+																						val:        "[_a-zA-Z0-9]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 38, offset: 2324},
+																					expr: &seqExpr{
+																						pos: position{line: 27, col: 41, offset: 2327},
+																						exprs: []any{
+																							&litMatcher{
+																								pos:        position{line: 27, col: 41, offset: 2327},
+																								val:        ".",
+																								ignoreCase: false,
+																								want:       "\".\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 27, col: 45, offset: 2331},
+																								val:        "[_a-zA-Z0-9]",
+																								chars:      []rune{'_'},
+																								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 31, col: 24, offset: 2659},
+																		run: (*parser).callonPrimary489,
+																		expr: &seqExpr{
+																			pos: position{line: 31, col: 24, offset: 2659},
+																			exprs: []any{
+																				&zeroOrOneExpr{
+																					pos: position{line: 31, col: 24, offset: 2659},
+																					expr: &litMatcher{
+																						pos:        position{line: 31, col: 24, offset: 2659},
+																						val:        "-",
+																						ignoreCase: false,
+																						want:       "\"-\"",
+																					},
+																				},
+																				&choiceExpr{
+																					pos: position{line: 30, col: 24, offset: 2596},
+																					alternatives: []any{
+																						&litMatcher{
+																							pos:        position{line: 30, col: 24, offset: 2596},
+																							val:        "0",
+																							ignoreCase: false,
+																							want:       "\"0\"",
+																						},
+																						&seqExpr{
+																							pos: position{line: 30, col: 30, offset: 2602},
+																							exprs: []any{
+																								&charClassMatcher{
+																									pos:        position{line: 41, col: 24, offset: 3604},
+																									val:        "[1-9]",
+																									ranges:     []rune{'1', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																								&zeroOrMoreExpr{
+																									pos: position{line: 30, col: 50, offset: 2622},
+																									expr: &charClassMatcher{
+																										pos:        position{line: 32, col: 24, offset: 2762},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																				&zeroOrOneExpr{
+																					pos: position{line: 31, col: 37, offset: 2672},
+																					expr: &seqExpr{
+																						pos: position{line: 31, col: 39, offset: 2674},
+																						exprs: []any{
+																							&litMatcher{
+																								pos:        position{line: 31, col: 39, offset: 2674},
+																								val:        ".",
+																								ignoreCase: false,
+																								want:       "\".\"",
+																							},
+																							&oneOrMoreExpr{
+																								pos: position{line: 31, col: 43, offset: 2678},
+																								expr: &charClassMatcher{
+																									pos:        position{line: 32, col: 24, offset: 2762},
+																									val:        "[0-9]",
+																									ranges:     []rune{'0', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 63, col: 37, offset: 5290},
+																		run: (*parser).callonPrimary504,
+																		expr: &litMatcher{
+																			pos:        position{line: 63, col: 37, offset: 5290},
+																			val:        "*",
+																			ignoreCase: false,
+																			want:       "\"*\"",
+																		},
+																	},
+																},
+															},
+														},
+														&zeroOrMoreExpr{
+															pos: position{line: 65, col: 24, offset: 5441},
+															expr: &charClassMatcher{
+																pos:        position{line: 65, col: 24, offset: 5441},
+																val:        "[ \\t\\r\\n]",
+																chars:      []rune{' ', '\t', '\r', '\n'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+														&choiceExpr{
+															pos: position{line: 62, col: 24, offset: 5242},
+															alternatives: []any{
+																&litMatcher{
+																	pos:        position{line: 62, col: 24, offset: 5242},
+																	val:        "TO",
+																	ignoreCase: false,
+																	want:       "\"TO\"",
+																},
+																&litMatcher{
+																	pos:        position{line: 62, col: 31, offset: 5249},
+																	val:        "to",
+																	ignoreCase: false,
+																	want:       "\"to\"",
+																},
+															},
+														},
+														&zeroOrMoreExpr{
+															pos: position{line: 65, col: 24, offset: 5441},
+															expr: &charClassMatcher{
+																pos:        position{line: 65, col: 24, offset: 5441},
+																val:        "[ \\t\\r\\n]",
+																chars:      []rune{' ', '\t', '\r', '\n'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+														&labeledExpr{
+															pos:   position{line: 57, col: 68, offset: 4849},
+															label: "high",
+															expr: &choiceExpr{
+																pos: position{line: 63, col: 24, offset: 5277},
+																alternatives: []any{
+																	&actionExpr{
+																		pos: position{line: 33, col: 24, offset: 2791},
+																		run: (*parser).callonPrimary515,
+																		expr: &seqExpr{
+																			pos: position{line: 33, col: 24, offset: 2791},
+																			exprs: []any{
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&litMatcher{
+																					pos:        position{line: 34, col: 76, offset: 2948},
+																					val:        "-",
+																					ignoreCase: false,
+																					want:       "\"-\"",
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&litMatcher{
+																					pos:        position{line: 34, col: 106, offset: 2978},
+																					val:        "-",
+																					ignoreCase: false,
+																					want:       "\"-\"",
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&zeroOrOneExpr{
+																					pos: position{line: 33, col: 29, offset: 2796},
+																					expr: &seqExpr{
+																						pos: position{line: 33, col: 31, offset: 2798},
+																						exprs: []any{
+																							&charClassMatcher{
+																								pos:        position{line: 33, col: 31, offset: 2798},
+																								val:        "[Tt]",
+																								chars:      []rune{'T', 't'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&litMatcher{
+																								pos:        position{line: 35, col: 50, offset: 3057},
+																								val:        ":",
+																								ignoreCase: false,
+																								want:       "\":\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&litMatcher{
+																								pos:        position{line: 35, col: 80, offset: 3087},
+																								val:        ":",
+																								ignoreCase: false,
+																								want:       "\":\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&zeroOrOneExpr{
+																								pos: position{line: 35, col: 110, offset: 3117},
+																								expr: &seqExpr{
+																									pos: position{line: 35, col: 112, offset: 3119},
+																									exprs: []any{
+																										&litMatcher{
+																											pos:        position{line: 35, col: 112, offset: 3119},
+																											val:        ".",
+																											ignoreCase: false,
+																											want:       "\".\"",
+																										},
+																										&oneOrMoreExpr{
+																											pos: position{line: 35, col: 116, offset: 3123},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																									},
+																								},
+																							},
+																							&choiceExpr{
+																								pos: position{line: 36, col: 24, offset: 3172},
+																								alternatives: []any{
+																									&charClassMatcher{
+																										pos:        position{line: 36, col: 24, offset: 3172},
+																										val:        "[Zz]",
+																										chars:      []rune{'Z', 'z'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																									&seqExpr{
+																										pos: position{line: 36, col: 31, offset: 3179},
+																										exprs: []any{
+																											&charClassMatcher{
+																												pos:        position{line: 36, col: 31, offset: 3179},
+																												val:        "[+-]",
+																												chars:      []rune{'+', '-'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&litMatcher{
+																												pos:        position{line: 36, col: 62, offset: 3210},
+																												val:        ":",
+																												ignoreCase: false,
+																												want:       "\":\"",
+																											},
+																											&charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 24, offset: 2310},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 27, col: 25, offset: 2311},
+																						val:        "[_a-zA-Z0-9]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 38, offset: 2324},
+																					expr: &seqExpr{
+																						pos: position{line: 27, col: 41, offset: 2327},
+																						exprs: []any{
+																							&litMatcher{
+																								pos:        position{line: 27, col: 41, offset: 2327},
+																								val:        ".",
+																								ignoreCase: false,
+																								want:       "\".\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 27, col: 45, offset: 2331},
+																								val:        "[_a-zA-Z0-9]",
+																								chars:      []rune{'_'},
+																								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 37, col: 24, offset: 3263},
+																		run: (*parser).callonPrimary558,
+																		expr: &seqExpr{
+																			pos: position{line: 37, col: 24, offset: 3263},
+																			exprs: []any{
+																				&litMatcher{
+																					pos:        position{line: 37, col: 24, offset: 3263},
+																					val:        "now",
+																					ignoreCase: false,
+																					want:       "\"now\"",
+																				},
+																				&zeroOrOneExpr{
+																					pos: position{line: 37, col: 30, offset: 3269},
+																					expr: &seqExpr{
+																						pos: position{line: 37, col: 32, offset: 3271},
+																						exprs: []any{
+																							&charClassMatcher{
+																								pos:        position{line: 37, col: 32, offset: 3271},
+																								val:        "[+-]",
+																								chars:      []rune{'+', '-'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&oneOrMoreExpr{
+																								pos: position{line: 39, col: 24, offset: 3477},
+																								expr: &seqExpr{
+																									pos: position{line: 39, col: 26, offset: 3479},
+																									exprs: []any{
+																										&oneOrMoreExpr{
+																											pos: position{line: 39, col: 26, offset: 3479},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																										&choiceExpr{
+																											pos: position{line: 40, col: 24, offset: 3532},
+																											alternatives: []any{
+																												&litMatcher{
+																													pos:        position{line: 40, col: 24, offset: 3532},
+																													val:        "ns",
+																													ignoreCase: false,
+																													want:       "\"ns\"",
+																												},
+																												&litMatcher{
+																													pos:        position{line: 40, col: 31, offset: 3539},
+																													val:        "us",
+																													ignoreCase: false,
+																													want:       "\"us\"",
+																												},
+																												&litMatcher{
+																													pos:        position{line: 40, col: 38, offset: 3546},
+																													val:        "ms",
+																													ignoreCase: false,
+																													want:       "\"ms\"",
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 40, col: 45, offset: 3553},
+																													val:        "[smhdw]",
+																													chars:      []rune{'s', 'm', 'h', 'd', 'w'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 24, offset: 2310},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 27, col: 25, offset: 2311},
+																						val:        "[_a-zA-Z0-9]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 38, offset: 2324},
+																					expr: &seqExpr{
+																						pos: position{line: 27, col: 41, offset: 2327},
+																						exprs: []any{
+																							&litMatcher{
+																								pos:        position{line: 27, col: 41, offset: 2327},
+																								val:        ".",
+																								ignoreCase: false,
+																								want:       "\".\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 27, col: 45, offset: 2331},
+																								val:        "[_a-zA-Z0-9]",
+																								chars:      []rune{'_'},
+																								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 38, col: 24, offset: 3372},
+																		run: (*parser).callonPrimary579,
+																		expr: &seqExpr{
+																			pos: position{line: 38, col: 24, offset: 3372},
+																			exprs: []any{
+																				&zeroOrOneExpr{
+																					pos: position{line: 38, col: 24, offset: 3372},
+																					expr: &litMatcher{
+																						pos:        position{line: 38, col: 24, offset: 3372},
+																						val:        "-",
+																						ignoreCase: false,
+																						want:       "\"-\"",
+																					},
+																				},
+																				&oneOrMoreExpr{
+																					pos: position{line: 39, col: 24, offset: 3477},
+																					expr: &seqExpr{
+																						pos: position{line: 39, col: 26, offset: 3479},
+																						exprs: []any{
+																							&oneOrMoreExpr{
+																								pos: position{line: 39, col: 26, offset: 3479},
+																								expr: &charClassMatcher{
+																									pos:        position{line: 32, col: 24, offset: 2762},
+																									val:        "[0-9]",
+																									ranges:     []rune{'0', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																							},
+																							&choiceExpr{
+																								pos: position{line: 40, col: 24, offset: 3532},
+																								alternatives: []any{
+																									&litMatcher{
+																										pos:        position{line: 40, col: 24, offset: 3532},
+																										val:        "ns",
+																										ignoreCase: false,
+																										want:       "\"ns\"",
+																									},
+																									&litMatcher{
+																										pos:        position{line: 40, col: 31, offset: 3539},
+																										val:        "us",
+																										ignoreCase: false,
+																										want:       "\"us\"",
+																									},
+																									&litMatcher{
+																										pos:        position{line: 40, col: 38, offset: 3546},
+																										val:        "ms",
+																										ignoreCase: false,
+																										want:       "\"ms\"",
+																									},
+																									&charClassMatcher{
+																										pos:        position{line: 40, col: 45, offset: 3553},
+																										val:        "[smhdw]",
+																										chars:      []rune{'s', 'm', 'h', 'd', 'w'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 24, offset: 2310},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 27, col: 25, offset: 2311},
+																						val:        "[_a-zA-Z0-9]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 38, offset: 2324},
+																					expr: &seqExpr{
+																						pos: position{line: 27, col: 41, offset: 2327},
+																						exprs: []any{
+																							&litMatcher{
+																								pos:        position{line: 27, col: 41, offset: 2327},
+																								val:        ".",
+																								ignoreCase: false,
+																								want:       "\".\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 27, col: 45, offset: 2331},
+																								val:        "[_a-zA-Z0-9]",
+																								chars:      []rune{'_'},
+																								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 31, col: 24, offset: 2659},
+																		run: (*parser).callonPrimary598,
+																		expr: &seqExpr{
+																			pos: position{line: 31, col: 24, offset: 2659},
+																			exprs: []any{
+																				&zeroOrOneExpr{
+																					pos: position{line: 31, col: 24, offset: 2659},
+																					expr: &litMatcher{
+																						pos:        position{line: 31, col: 24, offset: 2659},
+																						val:        "-",
+																						ignoreCase: false,
+																						want:       "\"-\"",
+																					},
+																				},
+																				&choiceExpr{
+																					pos: position{line: 30, col: 24, offset: 2596},
+																					alternatives: []any{
+																						&litMatcher{
+																							pos:        position{line: 30, col: 24, offset: 2596},
+																							val:        "0",
+																							ignoreCase: false,
+																							want:       "\"0\"",
+																						},
+																						&seqExpr{
+																							pos: position{line: 30, col: 30, offset: 2602},
+																							exprs: []any{
+																								&charClassMatcher{
+																									pos:        position{line: 41, col: 24, offset: 3604},
+																									val:        "[1-9]",
+																									ranges:     []rune{'1', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																								&zeroOrMoreExpr{
+																									pos: position{line: 30, col: 50, offset: 2622},
+																									expr: &charClassMatcher{
+																										pos:        position{line: 32, col: 24, offset: 2762},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																				&zeroOrOneExpr{
+																					pos: position{line: 31, col: 37, offset: 2672},
+																					expr: &seqExpr{
+																						pos: position{line: 31, col: 39, offset: 2674},
+																						exprs: []any{
+																							&litMatcher{
+																								pos:        position{line: 31, col: 39, offset: 2674},
+																								val:        ".",
+																								ignoreCase: false,
+																								want:       "\".\"",
+																							},
+																							&oneOrMoreExpr{
+																								pos: position{line: 31, col: 43, offset: 2678},
+																								expr: &charClassMatcher{
+																									pos:        position{line: 32, col: 24, offset: 2762},
+																									val:        "[0-9]",
+																									ranges:     []rune{'0', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 63, col: 37, offset: 5290},
+																		run: (*parser).callonPrimary613,
+																		expr: &litMatcher{
+																			pos:        position{line: 63, col: 37, offset: 5290},
+																			val:        "*",
+																			ignoreCase: false,
+																			want:       "\"*\"",
+																		},
+																	},
+																},
+															},
+														},
+														&zeroOrMoreExpr{
+															pos: position{line: 65, col: 24, offset: 5441},
+															expr: &charClassMatcher{
+																pos:        position{line: 65, col: 24, offset: 5441},
+																val:        "[ \\t\\r\\n]",
+																chars:      []rune{' ', '\t', '\r', '\n'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+														&labeledExpr{
+															pos:   position{line: 57, col: 86, offset: 4867},
+															label: "right",
+															expr: &charClassMatcher{
+																pos:        position{line: 61, col: 24, offset: 5209},
+																val:        "[]}]",
+																chars:      []rune{']', '}'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 59, col: 24, offset: 5043},
+												run: (*parser).callonPrimary619,
+												expr: &seqExpr{
+													pos: position{line: 59, col: 24, offset: 5043},
+													exprs: []any{
+														&labeledExpr{
+															pos:   position{line: 59, col: 24, offset: 5043},
+															label: "low",
+															expr: &choiceExpr{
+																pos: position{line: 64, col: 24, offset: 5374},
+																alternatives: []any{
+																	&actionExpr{
+																		pos: position{line: 33, col: 24, offset: 2791},
+																		run: (*parser).callonPrimary623,
+																		expr: &seqExpr{
+																			pos: position{line: 33, col: 24, offset: 2791},
+																			exprs: []any{
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&litMatcher{
+																					pos:        position{line: 34, col: 76, offset: 2948},
+																					val:        "-",
+																					ignoreCase: false,
+																					want:       "\"-\"",
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&litMatcher{
+																					pos:        position{line: 34, col: 106, offset: 2978},
+																					val:        "-",
+																					ignoreCase: false,
+																					want:       "\"-\"",
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&zeroOrOneExpr{
+																					pos: position{line: 33, col: 29, offset: 2796},
+																					expr: &seqExpr{
+																						pos: position{line: 33, col: 31, offset: 2798},
+																						exprs: []any{
+																							&charClassMatcher{
+																								pos:        position{line: 33, col: 31, offset: 2798},
+																								val:        "[Tt]",
+																								chars:      []rune{'T', 't'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&litMatcher{
+																								pos:        position{line: 35, col: 50, offset: 3057},
+																								val:        ":",
+																								ignoreCase: false,
+																								want:       "\":\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&litMatcher{
+																								pos:        position{line: 35, col: 80, offset: 3087},
+																								val:        ":",
+																								ignoreCase: false,
+																								want:       "\":\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&zeroOrOneExpr{
+																								pos: position{line: 35, col: 110, offset: 3117},
+																								expr: &seqExpr{
+																									pos: position{line: 35, col: 112, offset: 3119},
+																									exprs: []any{
+																										&litMatcher{
+																											pos:        position{line: 35, col: 112, offset: 3119},
+																											val:        ".",
+																											ignoreCase: false,
+																											want:       "\".\"",
+																										},
+																										&oneOrMoreExpr{
+																											pos: position{line: 35, col: 116, offset: 3123},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																									},
+																								},
+																							},
+																							&choiceExpr{
+																								pos: position{line: 36, col: 24, offset: 3172},
+																								alternatives: []any{
+																									&charClassMatcher{
+																										pos:        position{line: 36, col: 24, offset: 3172},
+																										val:        "[Zz]",
+																										chars:      []rune{'Z', 'z'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																									&seqExpr{
+																										pos: position{line: 36, col: 31, offset: 3179},
+																										exprs: []any{
+																											&charClassMatcher{
+																												pos:        position{line: 36, col: 31, offset: 3179},
+																												val:        "[+-]",
+																												chars:      []rune{'+', '-'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&litMatcher{
+																												pos:        position{line: 36, col: 62, offset: 3210},
+																												val:        ":",
+																												ignoreCase: false,
+																												want:       "\":\"",
+																											},
+																											&charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 24, offset: 2310},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 27, col: 25, offset: 2311},
+																						val:        "[_a-zA-Z0-9]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 38, offset: 2324},
+																					expr: &seqExpr{
+																						pos: position{line: 27, col: 41, offset: 2327},
+																						exprs: []any{
+																							&litMatcher{
+																								pos:        position{line: 27, col: 41, offset: 2327},
+																								val:        ".",
+																								ignoreCase: false,
+																								want:       "\".\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 27, col: 45, offset: 2331},
+																								val:        "[_a-zA-Z0-9]",
+																								chars:      []rune{'_'},
+																								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 37, col: 24, offset: 3263},
+																		run: (*parser).callonPrimary666,
+																		expr: &seqExpr{
+																			pos: position{line: 37, col: 24, offset: 3263},
+																			exprs: []any{
+																				&litMatcher{
+																					pos:        position{line: 37, col: 24, offset: 3263},
+																					val:        "now",
+																					ignoreCase: false,
+																					want:       "\"now\"",
+																				},
+																				&zeroOrOneExpr{
+																					pos: position{line: 37, col: 30, offset: 3269},
+																					expr: &seqExpr{
+																						pos: position{line: 37, col: 32, offset: 3271},
+																						exprs: []any{
+																							&charClassMatcher{
+																								pos:        position{line: 37, col: 32, offset: 3271},
+																								val:        "[+-]",
+																								chars:      []rune{'+', '-'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&oneOrMoreExpr{
+																								pos: position{line: 39, col: 24, offset: 3477},
+																								expr: &seqExpr{
+																									pos: position{line: 39, col: 26, offset: 3479},
+																									exprs: []any{
+																										&oneOrMoreExpr{
+																											pos: position{line: 39, col: 26, offset: 3479},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																										&choiceExpr{
+																											pos: position{line: 40, col: 24, offset: 3532},
+																											alternatives: []any{
+																												&litMatcher{
+																													pos:        position{line: 40, col: 24, offset: 3532},
+																													val:        "ns",
+																													ignoreCase: false,
+																													want:       "\"ns\"",
+																												},
+																												&litMatcher{
+																													pos:        position{line: 40, col: 31, offset: 3539},
+																													val:        "us",
+																													ignoreCase: false,
+																													want:       "\"us\"",
+																												},
+																												&litMatcher{
+																													pos:        position{line: 40, col: 38, offset: 3546},
+																													val:        "ms",
+																													ignoreCase: false,
+																													want:       "\"ms\"",
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 40, col: 45, offset: 3553},
+																													val:        "[smhdw]",
+																													chars:      []rune{'s', 'm', 'h', 'd', 'w'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 24, offset: 2310},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 27, col: 25, offset: 2311},
+																						val:        "[_a-zA-Z0-9]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 38, offset: 2324},
+																					expr: &seqExpr{
+																						pos: position{line: 27, col: 41, offset: 2327},
+																						exprs: []any{
+																							&litMatcher{
+																								pos:        position{line: 27, col: 41, offset: 2327},
+																								val:        ".",
+																								ignoreCase: false,
+																								want:       "\".\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 27, col: 45, offset: 2331},
+																								val:        "[_a-zA-Z0-9]",
+																								chars:      []rune{'_'},
+																								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 38, col: 24, offset: 3372},
+																		run: (*parser).callonPrimary687,
+																		expr: &seqExpr{
+																			pos: position{line: 38, col: 24, offset: 3372},
+																			exprs: []any{
+																				&zeroOrOneExpr{
+																					pos: position{line: 38, col: 24, offset: 3372},
+																					expr: &litMatcher{
+																						pos:        position{line: 38, col: 24, offset: 3372},
+																						val:        "-",
+																						ignoreCase: false,
+																						want:       "\"-\"",
+																					},
+																				},
+																				&oneOrMoreExpr{
+																					pos: position{line: 39, col: 24, offset: 3477},
+																					expr: &seqExpr{
+																						pos: position{line: 39, col: 26, offset: 3479},
+																						exprs: []any{
+																							&oneOrMoreExpr{
+																								pos: position{line: 39, col: 26, offset: 3479},
+																								expr: &charClassMatcher{
+																									pos:        position{line: 32, col: 24, offset: 2762},
+																									val:        "[0-9]",
+																									ranges:     []rune{'0', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																							},
+																							&choiceExpr{
+																								pos: position{line: 40, col: 24, offset: 3532},
+																								alternatives: []any{
+																									&litMatcher{
+																										pos:        position{line: 40, col: 24, offset: 3532},
+																										val:        "ns",
+																										ignoreCase: false,
+																										want:       "\"ns\"",
+																									},
+																									&litMatcher{
+																										pos:        position{line: 40, col: 31, offset: 3539},
+																										val:        "us",
+																										ignoreCase: false,
+																										want:       "\"us\"",
+																									},
+																									&litMatcher{
+																										pos:        position{line: 40, col: 38, offset: 3546},
+																										val:        "ms",
+																										ignoreCase: false,
+																										want:       "\"ms\"",
+																									},
+																									&charClassMatcher{
+																										pos:        position{line: 40, col: 45, offset: 3553},
+																										val:        "[smhdw]",
+																										chars:      []rune{'s', 'm', 'h', 'd', 'w'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 24, offset: 2310},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 27, col: 25, offset: 2311},
+																						val:        "[_a-zA-Z0-9]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 38, offset: 2324},
+																					expr: &seqExpr{
+																						pos: position{line: 27, col: 41, offset: 2327},
+																						exprs: []any{
+																							&litMatcher{
+																								pos:        position{line: 27, col: 41, offset: 2327},
+																								val:        ".",
+																								ignoreCase: false,
+																								want:       "\".\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 27, col: 45, offset: 2331},
+																								val:        "[_a-zA-Z0-9]",
+																								chars:      []rune{'_'},
+																								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 31, col: 24, offset: 2659},
+																		run: (*parser).callonPrimary706,
+																		expr: &seqExpr{
+																			pos: position{line: 31, col: 24, offset: 2659},
+																			exprs: []any{
+																				&zeroOrOneExpr{
+																					pos: position{line: 31, col: 24, offset: 2659},
+																					expr: &litMatcher{
+																						pos:        position{line: 31, col: 24, offset: 2659},
+																						val:        "-",
+																						ignoreCase: false,
+																						want:       "\"-\"",
+																					},
+																				},
+																				&choiceExpr{
+																					pos: position{line: 30, col: 24, offset: 2596},
+																					alternatives: []any{
+																						&litMatcher{
+																							pos:        position{line: 30, col: 24, offset: 2596},
+																							val:        "0",
+																							ignoreCase: false,
+																							want:       "\"0\"",
+																						},
+																						&seqExpr{
+																							pos: position{line: 30, col: 30, offset: 2602},
+																							exprs: []any{
+																								&charClassMatcher{
+																									pos:        position{line: 41, col: 24, offset: 3604},
+																									val:        "[1-9]",
+																									ranges:     []rune{'1', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																								&zeroOrMoreExpr{
+																									pos: position{line: 30, col: 50, offset: 2622},
+																									expr: &charClassMatcher{
+																										pos:        position{line: 32, col: 24, offset: 2762},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																				&zeroOrOneExpr{
+																					pos: position{line: 31, col: 37, offset: 2672},
+																					expr: &seqExpr{
+																						pos: position{line: 31, col: 39, offset: 2674},
+																						exprs: []any{
+																							&litMatcher{
+																								pos:        position{line: 31, col: 39, offset: 2674},
+																								val:        ".",
+																								ignoreCase: false,
+																								want:       "\".\"",
+																							},
+																							&oneOrMoreExpr{
+																								pos: position{line: 31, col: 43, offset: 2678},
+																								expr: &charClassMatcher{
+																									pos:        position{line: 32, col: 24, offset: 2762},
+																									val:        "[0-9]",
+																									ranges:     []rune{'0', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 59, col: 39, offset: 5058},
+															val:        "..",
+															ignoreCase: false,
+															want:       "\"..\"",
+														},
+														&labeledExpr{
+															pos:   position{line: 59, col: 44, offset: 5063},
+															label: "high",
+															expr: &choiceExpr{
+																pos: position{line: 64, col: 24, offset: 5374},
+																alternatives: []any{
+																	&actionExpr{
+																		pos: position{line: 33, col: 24, offset: 2791},
+																		run: (*parser).callonPrimary724,
+																		expr: &seqExpr{
+																			pos: position{line: 33, col: 24, offset: 2791},
+																			exprs: []any{
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&litMatcher{
+																					pos:        position{line: 34, col: 76, offset: 2948},
+																					val:        "-",
+																					ignoreCase: false,
+																					want:       "\"-\"",
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&litMatcher{
+																					pos:        position{line: 34, col: 106, offset: 2978},
+																					val:        "-",
+																					ignoreCase: false,
+																					want:       "\"-\"",
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&charClassMatcher{
+																					pos:        position{line: 32, col: 24, offset: 2762},
+																					val:        "[0-9]",
+																					ranges:     []rune{'0', '9'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																				&zeroOrOneExpr{
+																					pos: position{line: 33, col: 29, offset: 2796},
+																					expr: &seqExpr{
+																						pos: position{line: 33, col: 31, offset: 2798},
+																						exprs: []any{
+																							&charClassMatcher{
+																								pos:        position{line: 33, col: 31, offset: 2798},
+																								val:        "[Tt]",
+																								chars:      []rune{'T', 't'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&litMatcher{
+																								pos:        position{line: 35, col: 50, offset: 3057},
+																								val:        ":",
+																								ignoreCase: false,
+																								want:       "\":\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&litMatcher{
+																								pos:        position{line: 35, col: 80, offset: 3087},
+																								val:        ":",
+																								ignoreCase: false,
+																								want:       "\":\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 32, col: 24, offset: 2762},
+																								val:        "[0-9]",
+																								ranges:     []rune{'0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&zeroOrOneExpr{
+																								pos: position{line: 35, col: 110, offset: 3117},
+																								expr: &seqExpr{
+																									pos: position{line: 35, col: 112, offset: 3119},
+																									exprs: []any{
+																										&litMatcher{
+																											pos:        position{line: 35, col: 112, offset: 3119},
+																											val:        ".",
+																											ignoreCase: false,
+																											want:       "\".\"",
+																										},
+																										&oneOrMoreExpr{
+																											pos: position{line: 35, col: 116, offset: 3123},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																									},
+																								},
+																							},
+																							&choiceExpr{
+																								pos: position{line: 36, col: 24, offset: 3172},
+																								alternatives: []any{
+																									&charClassMatcher{
+																										pos:        position{line: 36, col: 24, offset: 3172},
+																										val:        "[Zz]",
+																										chars:      []rune{'Z', 'z'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																									&seqExpr{
+																										pos: position{line: 36, col: 31, offset: 3179},
+																										exprs: []any{
+																											&charClassMatcher{
+																												pos:        position{line: 36, col: 31, offset: 3179},
+																												val:        "[+-]",
+																												chars:      []rune{'+', '-'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&litMatcher{
+																												pos:        position{line: 36, col: 62, offset: 3210},
+																												val:        ":",
+																												ignoreCase: false,
+																												want:       "\":\"",
+																											},
+																											&charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																											&charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 24, offset: 2310},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 27, col: 25, offset: 2311},
+																						val:        "[_a-zA-Z0-9]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 38, offset: 2324},
+																					expr: &seqExpr{
+																						pos: position{line: 27, col: 41, offset: 2327},
+																						exprs: []any{
+																							&litMatcher{
+																								pos:        position{line: 27, col: 41, offset: 2327},
+																								val:        ".",
+																								ignoreCase: false,
+																								want:       "\".\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 27, col: 45, offset: 2331},
+																								val:        "[_a-zA-Z0-9]",
+																								chars:      []rune{'_'},
+																								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 37, col: 24, offset: 3263},
+																		run: (*parser).callonPrimary767,
+																		expr: &seqExpr{
+																			pos: position{line: 37, col: 24, offset: 3263},
+																			exprs: []any{
+																				&litMatcher{
+																					pos:        position{line: 37, col: 24, offset: 3263},
+																					val:        "now",
+																					ignoreCase: false,
+																					want:       "\"now\"",
+																				},
+																				&zeroOrOneExpr{
+																					pos: position{line: 37, col: 30, offset: 3269},
+																					expr: &seqExpr{
+																						pos: position{line: 37, col: 32, offset: 3271},
+																						exprs: []any{
+																							&charClassMatcher{
+																								pos:        position{line: 37, col: 32, offset: 3271},
+																								val:        "[+-]",
+																								chars:      []rune{'+', '-'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																							&oneOrMoreExpr{
+																								pos: position{line: 39, col: 24, offset: 3477},
+																								expr: &seqExpr{
+																									pos: position{line: 39, col: 26, offset: 3479},
+																									exprs: []any{
+																										&oneOrMoreExpr{
+																											pos: position{line: 39, col: 26, offset: 3479},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 32, col: 24, offset: 2762},
+																												val:        "[0-9]",
+																												ranges:     []rune{'0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																										&choiceExpr{
+																											pos: position{line: 40, col: 24, offset: 3532},
+																											alternatives: []any{
+																												&litMatcher{
+																													pos:        position{line: 40, col: 24, offset: 3532},
+																													val:        "ns",
+																													ignoreCase: false,
+																													want:       "\"ns\"",
+																												},
+																												&litMatcher{
+																													pos:        position{line: 40, col: 31, offset: 3539},
+																													val:        "us",
+																													ignoreCase: false,
+																													want:       "\"us\"",
+																												},
+																												&litMatcher{
+																													pos:        position{line: 40, col: 38, offset: 3546},
+																													val:        "ms",
+																													ignoreCase: false,
+																													want:       "\"ms\"",
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 40, col: 45, offset: 3553},
+																													val:        "[smhdw]",
+																													chars:      []rune{'s', 'm', 'h', 'd', 'w'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 24, offset: 2310},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 27, col: 25, offset: 2311},
+																						val:        "[_a-zA-Z0-9]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 38, offset: 2324},
+																					expr: &seqExpr{
+																						pos: position{line: 27, col: 41, offset: 2327},
+																						exprs: []any{
+																							&litMatcher{
+																								pos:        position{line: 27, col: 41, offset: 2327},
+																								val:        ".",
+																								ignoreCase: false,
+																								want:       "\".\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 27, col: 45, offset: 2331},
+																								val:        "[_a-zA-Z0-9]",
+																								chars:      []rune{'_'},
+																								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 38, col: 24, offset: 3372},
+																		run: (*parser).callonPrimary788,
+																		expr: &seqExpr{
+																			pos: position{line: 38, col: 24, offset: 3372},
+																			exprs: []any{
+																				&zeroOrOneExpr{
+																					pos: position{line: 38, col: 24, offset: 3372},
+																					expr: &litMatcher{
+																						pos:        position{line: 38, col: 24, offset: 3372},
+																						val:        "-",
+																						ignoreCase: false,
+																						want:       "\"-\"",
+																					},
+																				},
+																				&oneOrMoreExpr{
+																					pos: position{line: 39, col: 24, offset: 3477},
+																					expr: &seqExpr{
+																						pos: position{line: 39, col: 26, offset: 3479},
+																						exprs: []any{
+																							&oneOrMoreExpr{
+																								pos: position{line: 39, col: 26, offset: 3479},
+																								expr: &charClassMatcher{
+																									pos:        position{line: 32, col: 24, offset: 2762},
+																									val:        "[0-9]",
+																									ranges:     []rune{'0', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																							},
+																							&choiceExpr{
+																								pos: position{line: 40, col: 24, offset: 3532},
+																								alternatives: []any{
+																									&litMatcher{
+																										pos:        position{line: 40, col: 24, offset: 3532},
+																										val:        "ns",
+																										ignoreCase: false,
+																										want:       "\"ns\"",
+																									},
+																									&litMatcher{
+																										pos:        position{line: 40, col: 31, offset: 3539},
+																										val:        "us",
+																										ignoreCase: false,
+																										want:       "\"us\"",
+																									},
+																									&litMatcher{
+																										pos:        position{line: 40, col: 38, offset: 3546},
+																										val:        "ms",
+																										ignoreCase: false,
+																										want:       "\"ms\"",
+																									},
+																									&charClassMatcher{
+																										pos:        position{line: 40, col: 45, offset: 3553},
+																										val:        "[smhdw]",
+																										chars:      []rune{'s', 'm', 'h', 'd', 'w'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 24, offset: 2310},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 27, col: 25, offset: 2311},
+																						val:        "[_a-zA-Z0-9]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&notExpr{
+																					pos: position{line: 27, col: 38, offset: 2324},
+																					expr: &seqExpr{
+																						pos: position{line: 27, col: 41, offset: 2327},
+																						exprs: []any{
+																							&litMatcher{
+																								pos:        position{line: 27, col: 41, offset: 2327},
+																								val:        ".",
+																								ignoreCase: false,
+																								want:       "\".\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 27, col: 45, offset: 2331},
+																								val:        "[_a-zA-Z0-9]",
+																								chars:      []rune{'_'},
+																								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&actionExpr{
+																		pos: position{line: 31, col: 24, offset: 2659},
+																		run: (*parser).callonPrimary807,
+																		expr: &seqExpr{
+																			pos: position{line: 31, col: 24, offset: 2659},
+																			exprs: []any{
+																				&zeroOrOneExpr{
+																					pos: position{line: 31, col: 24, offset: 2659},
+																					expr: &litMatcher{
+																						pos:        position{line: 31, col: 24, offset: 2659},
+																						val:        "-",
+																						ignoreCase: false,
+																						want:       "\"-\"",
+																					},
+																				},
+																				&choiceExpr{
+																					pos: position{line: 30, col: 24, offset: 2596},
+																					alternatives: []any{
+																						&litMatcher{
+																							pos:        position{line: 30, col: 24, offset: 2596},
+																							val:        "0",
+																							ignoreCase: false,
+																							want:       "\"0\"",
+																						},
+																						&seqExpr{
+																							pos: position{line: 30, col: 30, offset: 2602},
+																							exprs: []any{
+																								&charClassMatcher{
+																									pos:        position{line: 41, col: 24, offset: 3604},
+																									val:        "[1-9]",
+																									ranges:     []rune{'1', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																								&zeroOrMoreExpr{
+																									pos: position{line: 30, col: 50, offset: 2622},
+																									expr: &charClassMatcher{
+																										pos:        position{line: 32, col: 24, offset: 2762},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																				&zeroOrOneExpr{
+																					pos: position{line: 31, col: 37, offset: 2672},
+																					expr: &seqExpr{
+																						pos: position{line: 31, col: 39, offset: 2674},
+																						exprs: []any{
+																							&litMatcher{
+																								pos:        position{line: 31, col: 39, offset: 2674},
+																								val:        ".",
+																								ignoreCase: false,
+																								want:       "\".\"",
+																							},
+																							&oneOrMoreExpr{
+																								pos: position{line: 31, col: 43, offset: 2678},
+																								expr: &charClassMatcher{
+																									pos:        position{line: 32, col: 24, offset: 2762},
+																									val:        "[0-9]",
+																									ranges:     []rune{'0', '9'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 55, col: 24, offset: 4568},
+												run: (*parser).callonPrimary822,
+												expr: &seqExpr{
+													pos: position{line: 55, col: 24, offset: 4568},
+													exprs: []any{
+														&litMatcher{
+															pos:        position{line: 55, col: 24, offset: 4568},
+															val:        "[",
+															ignoreCase: false,
+															want:       "\"[\"",
+														},
+														&zeroOrMoreExpr{
+															pos: position{line: 65, col: 24, offset: 5441},
+															expr: &charClassMatcher{
+																pos:        position{line: 65, col: 24, offset: 5441},
+																val:        "[ \\t\\r\\n]",
+																chars:      []rune{' ', '\t', '\r', '\n'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+														&labeledExpr{
+															pos:   position{line: 55, col: 30, offset: 4574},
+															label: "values",
+															expr: &zeroOrOneExpr{
+																pos: position{line: 55, col: 37, offset: 4581},
+																expr: &actionExpr{
+																	pos: position{line: 56, col: 24, offset: 4688},
+																	run: (*parser).callonPrimary829,
+																	expr: &seqExpr{
+																		pos: position{line: 56, col: 24, offset: 4688},
+																		exprs: []any{
+																			&labeledExpr{
+																				pos:   position{line: 56, col: 24, offset: 4688},
+																				label: "head",
+																				expr: &choiceExpr{
+																					pos: position{line: 23, col: 24, offset: 1899},
+																					alternatives: []any{
+																						&actionExpr{
+																							pos: position{line: 45, col: 24, offset: 3927},
+																							run: (*parser).callonPrimary833,
+																							expr: &seqExpr{
+																								pos: position{line: 45, col: 24, offset: 3927},
+																								exprs: []any{
+																									&litMatcher{
+																										pos:        position{line: 45, col: 24, offset: 3927},
+																										val:        "\"",
+																										ignoreCase: false,
+																										want:       "\"\\\"\"",
+																									},
+																									&zeroOrMoreExpr{
+																										pos: position{line: 46, col: 24, offset: 4030},
+																										expr: &choiceExpr{
+																											pos: position{line: 46, col: 26, offset: 4032},
+																											alternatives: []any{
+																												&seqExpr{
+																													pos: position{line: 46, col: 26, offset: 4032},
+																													exprs: []any{
+																														&notExpr{
+																															pos: position{line: 46, col: 26, offset: 4032},
+																															expr: &charClassMatcher{
+																																pos:        position{line: 47, col: 24, offset: 4095},
+																																val:        "[\"\\\\\\x00-\\x1f]",
+																																chars:      []rune{'"', '\\'},
+																																ranges:     []rune{'\x00', '\x1f'},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																														},
+																														&anyMatcher{
+																															line: 46, col: 39, offset: 4045,
+																														},
+																													},
+																												},
+																												&seqExpr{
+																													pos: position{line: 46, col: 43, offset: 4049},
+																													exprs: []any{
+																														&litMatcher{
+																															pos:        position{line: 46, col: 43, offset: 4049},
+																															val:        "\\",
+																															ignoreCase: false,
+																															want:       "\"\\\\\"",
+																														},
+																														&choiceExpr{
+																															pos: position{line: 48, col: 24, offset: 4133},
+																															alternatives: []any{
+																																&charClassMatcher{
+																																	pos:        position{line: 49, col: 24, offset: 4189},
+																																	val:        "[\"\\\\/bfnrt]",
+																																	chars:      []rune{'"', '\\', '/', 'b', 'f', 'n', 'r', 't'},
+																																	ignoreCase: false,
+																																	inverted:   false,
+																																},
+																																&seqExpr{
+																																	pos: position{line: 50, col: 24, offset: 4224},
+																																	exprs: []any{
+																																		&litMatcher{
+																																			pos:        position{line: 50, col: 24, offset: 4224},
+																																			val:        "u",
+																																			ignoreCase: false,
+																																			want:       "\"u\"",
+																																		},
+																																		&charClassMatcher{
+																																			pos:        position{line: 51, col: 24, offset: 4287},
+																																			val:        "[0-9a-f]i",
+																																			ranges:     []rune{'0', '9', 'a', 'f'},
+																																			ignoreCase: true,
+																																			inverted:   false,
+																																		},
+																																		&charClassMatcher{
+																																			pos:        position{line: 51, col: 24, offset: 4287},
+																																			val:        "[0-9a-f]i",
+																																			ranges:     []rune{'0', '9', 'a', 'f'},
+																																			ignoreCase: true,
+																																			inverted:   false,
+																																		},
+																																		&charClassMatcher{
+																																			pos:        position{line: 51, col: 24, offset: 4287},
+																																			val:        "[0-9a-f]i",
+																																			ranges:     []rune{'0', '9', 'a', 'f'},
+																																			ignoreCase: true,
+																																			inverted:   false,
+																																		},
+																																		&charClassMatcher{
+																																			pos:        position{line: 51, col: 24, offset: 4287},
+																																			val:        "[0-9a-f]i",
+																																			ranges:     []rune{'0', '9', 'a', 'f'},
+																																			ignoreCase: true,
+																																			inverted:   false,
+																																		},
+																																	},
+																																},
+																															},
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																									&litMatcher{
+																										pos:        position{line: 45, col: 40, offset: 3943},
+																										val:        "\"",
+																										ignoreCase: false,
+																										want:       "\"\\\"\"",
+																									},
+																								},
+																							},
+																						},
+																						&actionExpr{
+																							pos: position{line: 33, col: 24, offset: 2791},
+																							run: (*parser).callonPrimary853,
+																							expr: &seqExpr{
+																								pos: position{line: 33, col: 24, offset: 2791},
+																								exprs: []any{
+																									&charClassMatcher{
+																										pos:        position{line: 32, col: 24, offset: 2762},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																									&charClassMatcher{
+																										pos:        position{line: 32, col: 24, offset: 2762},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																									&charClassMatcher{
+																										pos:        position{line: 32, col: 24, offset: 2762},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																									&charClassMatcher{
+																										pos:        position{line: 32, col: 24, offset: 2762},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																									&litMatcher{
+																										pos:        position{line: 34, col: 76, offset: 2948},
+																										val:        "-",
+																										ignoreCase: false,
+																										want:       "\"-\"",
+																									},
+																									&charClassMatcher{
+																										pos:        position{line: 32, col: 24, offset: 2762},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																									&charClassMatcher{
+																										pos:        position{line: 32, col: 24, offset: 2762},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																									&litMatcher{
+																										pos:        position{line: 34, col: 106, offset: 2978},
+																										val:        "-",
+																										ignoreCase: false,
+																										want:       "\"-\"",
+																									},
+																									&charClassMatcher{
+																										pos:        position{line: 32, col: 24, offset: 2762},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																									&charClassMatcher{
+																										pos:        position{line: 32, col: 24, offset: 2762},
+																										val:        "[0-9]",
+																										ranges:     []rune{'0', '9'},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																									&zeroOrOneExpr{
+																										pos: position{line: 33, col: 29, offset: 2796},
+																										expr: &seqExpr{
+																											pos: position{line: 33, col: 31, offset: 2798},
+																											exprs: []any{
+																												&charClassMatcher{
+																													pos:        position{line: 33, col: 31, offset: 2798},
+																													val:        "[Tt]",
+																													chars:      []rune{'T', 't'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 32, col: 24, offset: 2762},
+																													val:        "[0-9]",
+																													ranges:     []rune{'0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 32, col: 24, offset: 2762},
+																													val:        "[0-9]",
+																													ranges:     []rune{'0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&litMatcher{
+																													pos:        position{line: 35, col: 50, offset: 3057},
+																													val:        ":",
+																													ignoreCase: false,
+																													want:       "\":\"",
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 32, col: 24, offset: 2762},
+																													val:        "[0-9]",
+																													ranges:     []rune{'0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 32, col: 24, offset: 2762},
+																													val:        "[0-9]",
+																													ranges:     []rune{'0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&litMatcher{
+																													pos:        position{line: 35, col: 80, offset: 3087},
+																													val:        ":",
+																													ignoreCase: false,
+																													want:       "\":\"",
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 32, col: 24, offset: 2762},
+																													val:        "[0-9]",
+																													ranges:     []rune{'0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 32, col: 24, offset: 2762},
+																													val:        "[0-9]",
+																													ranges:     []rune{'0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&zeroOrOneExpr{
+																													pos: position{line: 35, col: 110, offset: 3117},
+																													expr: &seqExpr{
+																														pos: position{line: 35, col: 112, offset: 3119},
+																														exprs: []any{
+																															&litMatcher{
+																																pos:        position{line: 35, col: 112, offset: 3119},
+																																val:        ".",
+																																ignoreCase: false,
+																																want:       "\".\"",
+																															},
+																															&oneOrMoreExpr{
+																																pos: position{line: 35, col: 116, offset: 3123},
+																																expr: &charClassMatcher{
+																																	pos:        position{line: 32, col: 24, offset: 2762},
+																																	val:        "[0-9]",
+																																	ranges:     []rune{'0', '9'},
+																																	ignoreCase: false,
+																																	inverted:   false,
+																																},
+																															},
+																														},
+																													},
+																												},
+																												&choiceExpr{
+																													pos: position{line: 36, col: 24, offset: 3172},
+																													alternatives: []any{
+																														&charClassMatcher{
+																															pos:        position{line: 36, col: 24, offset: 3172},
+																															val:        "[Zz]",
+																															chars:      []rune{'Z', 'z'},
+																															ignoreCase: false,
+																															inverted:   false,
+																														},
+																														&seqExpr{
+																															pos: position{line: 36, col: 31, offset: 3179},
+																															exprs: []any{
+																																&charClassMatcher{
+																																	pos:        position{line: 36, col: 31, offset: 3179},
+																																	val:        "[+-]",
+																																	chars:      []rune{'+', '-'},
+																																	ignoreCase: false,
+																																	inverted:   false,
+																																},
+																																&charClassMatcher{
+																																	pos:        position{line: 32, col: 24, offset: 2762},
+																																	val:        "[0-9]",
+																																	ranges:     []rune{'0', '9'},
+																																	ignoreCase: false,
+																																	inverted:   false,
+																																},
+																																&charClassMatcher{
+																																	pos:        position{line: 32, col: 24, offset: 2762},
+																																	val:        "[0-9]",
+																																	ranges:     []rune{'0', '9'},
+																																	ignoreCase: false,
+																																	inverted:   false,
+																																},
+																																&litMatcher{
+																																	pos:        position{line: 36, col: 62, offset: 3210},
+																																	val:        ":",
+																																	ignoreCase: false,
+																																	want:       "\":\"",
+																																},
+																																&charClassMatcher{
+																																	pos:        position{line: 32, col: 24, offset: 2762},
+																																	val:        "[0-9]",
+																																	ranges:     []rune{'0', '9'},
+																																	ignoreCase: false,
+																																	inverted:   false,
+																																},
+																																&charClassMatcher{
+																																	pos:        position{line: 32, col: 24, offset: 2762},
+																																	val:        "[0-9]",
+																																	ranges:     []rune{'0', '9'},
+																																	ignoreCase: false,
+																																	inverted:   false,
+																																},
+																															},
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																									&notExpr{
+																										pos: position{line: 27, col: 24, offset: 2310},
+																										expr: &charClassMatcher{
+																											pos:        position{line: 27, col: 25, offset: 2311},
+																											val:        "[_a-zA-Z0-9]",
+																											chars:      []rune{'_'},
+																											ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																									},
+																									&notExpr{
+																										pos: position{line: 27, col: 38, offset: 2324},
+																										expr: &seqExpr{
+																											pos: position{line: 27, col: 41, offset: 2327},
+																											exprs: []any{
+																												&litMatcher{
+																													pos:        position{line: 27, col: 41, offset: 2327},
+																													val:        ".",
+																													ignoreCase: false,
+																													want:       "\".\"",
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 27, col: 45, offset: 2331},
+																													val:        "[_a-zA-Z0-9]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																						&actionExpr{
+																							pos: position{line: 37, col: 24, offset: 3263},
+																							run: (*parser).callonPrimary896,
+																							expr: &seqExpr{
+																								pos: position{line: 37, col: 24, offset: 3263},
+																								exprs: []any{
+																									&litMatcher{
+																										pos:        position{line: 37, col: 24, offset: 3263},
+																										val:        "now",
+																										ignoreCase: false,
+																										want:       "\"now\"",
+																									},
+																									&zeroOrOneExpr{
+																										pos: position{line: 37, col: 30, offset: 3269},
+																										expr: &seqExpr{
+																											pos: position{line: 37, col: 32, offset: 3271},
+																											exprs: []any{
+																												&charClassMatcher{
+																													pos:        position{line: 37, col: 32, offset: 3271},
+																													val:        "[+-]",
+																													chars:      []rune{'+', '-'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&oneOrMoreExpr{
+																													pos: position{line: 39, col: 24, offset: 3477},
+																													expr: &seqExpr{
+																														pos: position{line: 39, col: 26, offset: 3479},
+																														exprs: []any{
+																															&oneOrMoreExpr{
+																																pos: position{line: 39, col: 26, offset: 3479},
+																																expr: &charClassMatcher{
+																																	pos:        position{line: 32, col: 24, offset: 2762},
+																																	val:        "[0-9]",
+																																	ranges:     []rune{'0', '9'},
+																																	ignoreCase: false,
+																																	inverted:   false,
+																																},
+																															},
+																															&choiceExpr{
+																																pos: position{line: 40, col: 24, offset: 3532},
+																																alternatives: []any{
+																																	&litMatcher{
+																																		pos:        position{line: 40, col: 24, offset: 3532},
+																																		val:        "ns",
+																																		ignoreCase: false,
+																																		want:       "\"ns\"",
+																																	},
+																																	&litMatcher{
+																																		pos:        position{line: 40, col: 31, offset: 3539},
+																																		val:        "us",
+																																		ignoreCase: false,
+																																		want:       "\"us\"",
+																																	},
+																																	&litMatcher{
+																																		pos:        position{line: 40, col: 38, offset: 3546},
+																																		val:        "ms",
+																																		ignoreCase: false,
+																																		want:       "\"ms\"",
+																																	},
+																																	&charClassMatcher{
+																																		pos:        position{line: 40, col: 45, offset: 3553},
+																																		val:        "[smhdw]",
+																																		chars:      []rune{'s', 'm', 'h', 'd', 'w'},
+																																		ignoreCase: false,
+																																		inverted:   false,
+																																	},
+																																},
+																															},
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																									&notExpr{
+																										pos: position{line: 27, col: 24, offset: 2310},
+																										expr: &charClassMatcher{
+																											pos:        position{line: 27, col: 25, offset: 2311},
+																											val:        "[_a-zA-Z0-9]",
+																											chars:      []rune{'_'},
+																											ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																									},
+																									&notExpr{
+																										pos: position{line: 27, col: 38, offset: 2324},
+																										expr: &seqExpr{
+																											pos: position{line: 27, col: 41, offset: 2327},
+																											exprs: []any{
+																												&litMatcher{
+																													pos:        position{line: 27, col: 41, offset: 2327},
+																													val:        ".",
+																													ignoreCase: false,
+																													want:       "\".\"",
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 27, col: 45, offset: 2331},
+																													val:        "[_a-zA-Z0-9]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																						&actionExpr{
+																							pos: position{line: 38, col: 24, offset: 3372},
+																							run: (*parser).callonPrimary917,
+																							expr: &seqExpr{
+																								pos: position{line: 38, col: 24, offset: 3372},
+																								exprs: []any{
+																									&zeroOrOneExpr{
+																										pos: position{line: 38, col: 24, offset: 3372},
+																										expr: &litMatcher{
+																											pos:        position{line: 38, col: 24, offset: 3372},
+																											val:        "-",
+																											ignoreCase: false,
+																											want:       "\"-\"",
+																										},
+																									},
+																									&oneOrMoreExpr{
+																										pos: position{line: 39, col: 24, offset: 3477},
+																										expr: &seqExpr{
+																											pos: position{line: 39, col: 26, offset: 3479},
+																											exprs: []any{
+																												&oneOrMoreExpr{
+																													pos: position{line: 39, col: 26, offset: 3479},
+																													expr: &charClassMatcher{
+																														pos:        position{line: 32, col: 24, offset: 2762},
+																														val:        "[0-9]",
+																														ranges:     []rune{'0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																												},
+																												&choiceExpr{
+																													pos: position{line: 40, col: 24, offset: 3532},
+																													alternatives: []any{
+																														&litMatcher{
+																															pos:        position{line: 40, col: 24, offset: 3532},
+																															val:        "ns",
+																															ignoreCase: false,
+																															want:       "\"ns\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 40, col: 31, offset: 3539},
+																															val:        "us",
+																															ignoreCase: false,
+																															want:       "\"us\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 40, col: 38, offset: 3546},
+																															val:        "ms",
+																															ignoreCase: false,
+																															want:       "\"ms\"",
+																														},
+																														&charClassMatcher{
+																															pos:        position{line: 40, col: 45, offset: 3553},
+																															val:        "[smhdw]",
+																															chars:      []rune{'s', 'm', 'h', 'd', 'w'},
+																															ignoreCase: false,
+																															inverted:   false,
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																									&notExpr{
+																										pos: position{line: 27, col: 24, offset: 2310},
+																										expr: &charClassMatcher{
+																											pos:        position{line: 27, col: 25, offset: 2311},
+																											val:        "[_a-zA-Z0-9]",
+																											chars:      []rune{'_'},
+																											ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																									},
+																									&notExpr{
+																										pos: position{line: 27, col: 38, offset: 2324},
+																										expr: &seqExpr{
+																											pos: position{line: 27, col: 41, offset: 2327},
+																											exprs: []any{
+																												&litMatcher{
+																													pos:        position{line: 27, col: 41, offset: 2327},
+																													val:        ".",
+																													ignoreCase: false,
+																													want:       "\".\"",
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 27, col: 45, offset: 2331},
+																													val:        "[_a-zA-Z0-9]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																						&actionExpr{
+																							pos: position{line: 31, col: 24, offset: 2659},
+																							run: (*parser).callonPrimary936,
+																							expr: &seqExpr{
+																								pos: position{line: 31, col: 24, offset: 2659},
+																								exprs: []any{
+																									&zeroOrOneExpr{
+																										pos: position{line: 31, col: 24, offset: 2659},
+																										expr: &litMatcher{
+																											pos:        position{line: 31, col: 24, offset: 2659},
+																											val:        "-",
+																											ignoreCase: false,
+																											want:       "\"-\"",
+																										},
+																									},
+																									&choiceExpr{
+																										pos: position{line: 30, col: 24, offset: 2596},
+																										alternatives: []any{
+																											&litMatcher{
+																												pos:        position{line: 30, col: 24, offset: 2596},
+																												val:        "0",
+																												ignoreCase: false,
+																												want:       "\"0\"",
+																											},
+																											&seqExpr{
+																												pos: position{line: 30, col: 30, offset: 2602},
+																												exprs: []any{
+																													&charClassMatcher{
+																														pos:        position{line: 41, col: 24, offset: 3604},
+																														val:        "[1-9]",
+																														ranges:     []rune{'1', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																													&zeroOrMoreExpr{
+																														pos: position{line: 30, col: 50, offset: 2622},
+																														expr: &charClassMatcher{
+																															pos:        position{line: 32, col: 24, offset: 2762},
+																															val:        "[0-9]",
+																															ranges:     []rune{'0', '9'},
+																															ignoreCase: false,
+																															inverted:   false,
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																									&zeroOrOneExpr{
+																										pos: position{line: 31, col: 37, offset: 2672},
+																										expr: &seqExpr{
+																											pos: position{line: 31, col: 39, offset: 2674},
+																											exprs: []any{
+																												&litMatcher{
+																													pos:        position{line: 31, col: 39, offset: 2674},
+																													val:        ".",
+																													ignoreCase: false,
+																													want:       "\".\"",
+																												},
+																												&oneOrMoreExpr{
+																													pos: position{line: 31, col: 43, offset: 2678},
+																													expr: &charClassMatcher{
+																														pos:        position{line: 32, col: 24, offset: 2762},
+																														val:        "[0-9]",
+																														ranges:     []rune{'0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																						&actionExpr{
+																							pos: position{line: 28, col: 24, offset: 2369},
+																							run: (*parser).callonPrimary951,
+																							expr: &seqExpr{
+																								pos: position{line: 28, col: 24, offset: 2369},
+																								exprs: []any{
+																									&choiceExpr{
+																										pos: position{line: 28, col: 26, offset: 2371},
+																										alternatives: []any{
+																											&litMatcher{
+																												pos:        position{line: 28, col: 26, offset: 2371},
+																												val:        "true",
+																												ignoreCase: false,
+																												want:       "\"true\"",
+																											},
+																											&litMatcher{
+																												pos:        position{line: 28, col: 35, offset: 2380},
+																												val:        "TRUE",
+																												ignoreCase: false,
+																												want:       "\"TRUE\"",
+																											},
+																											&litMatcher{
+																												pos:        position{line: 28, col: 44, offset: 2389},
+																												val:        "false",
+																												ignoreCase: false,
+																												want:       "\"false\"",
+																											},
+																											&litMatcher{
+																												pos:        position{line: 28, col: 54, offset: 2399},
+																												val:        "FALSE",
+																												ignoreCase: false,
+																												want:       "\"FALSE\"",
+																											},
+																										},
+																									},
+																									&notExpr{
+																										pos: position{line: 27, col: 24, offset: 2310},
+																										expr: &charClassMatcher{
+																											pos:        position{line: 27, col: 25, offset: 2311},
+																											val:        "[_a-zA-Z0-9]",
+																											chars:      []rune{'_'},
+																											ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																									},
+																									&notExpr{
+																										pos: position{line: 27, col: 38, offset: 2324},
+																										expr: &seqExpr{
+																											pos: position{line: 27, col: 41, offset: 2327},
+																											exprs: []any{
+																												&litMatcher{
+																													pos:        position{line: 27, col: 41, offset: 2327},
+																													val:        ".",
+																													ignoreCase: false,
+																													want:       "\".\"",
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 27, col: 45, offset: 2331},
+																													val:        "[_a-zA-Z0-9]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																						&actionExpr{
+																							pos: position{line: 24, col: 24, offset: 1998},
+																							run: (*parser).callonPrimary964,
+																							expr: &actionExpr{
+																								pos: position{line: 25, col: 24, offset: 2152},
+																								run: (*parser).callonPrimary965,
+																								expr: &seqExpr{
+																									pos: position{line: 25, col: 24, offset: 2152},
+																									exprs: []any{
+																										&charClassMatcher{
+																											pos:        position{line: 26, col: 24, offset: 2264},
+																											val:        "[_a-zA-Z]",
+																											chars:      []rune{'_'},
+																											ranges:     []rune{'a', 'z', 'A', 'Z'},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																										&zeroOrMoreExpr{
+																											pos: position{line: 26, col: 33, offset: 2273},
+																											expr: &charClassMatcher{
+																												pos:        position{line: 26, col: 33, offset: 2273},
+																												val:        "[_a-zA-Z0-9]",
+																												chars:      []rune{'_'},
+																												ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																												ignoreCase: false,
+																												inverted:   false,
+																											},
+																										},
+																										&zeroOrMoreExpr{
+																											pos: position{line: 25, col: 37, offset: 2165},
+																											expr: &seqExpr{
+																												pos: position{line: 25, col: 38, offset: 2166},
+																												exprs: []any{
+																													&litMatcher{
+																														pos:        position{line: 25, col: 38, offset: 2166},
+																														val:        ".",
+																														ignoreCase: false,
+																														want:       "\".\"",
+																													},
+																													&charClassMatcher{
+																														pos:        position{line: 26, col: 24, offset: 2264},
+																														val:        "[_a-zA-Z]",
+																														chars:      []rune{'_'},
+																														ranges:     []rune{'a', 'z', 'A', 'Z'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																													&zeroOrMoreExpr{
+																														pos: position{line: 26, col: 33, offset: 2273},
+																														expr: &charClassMatcher{
+																															pos:        position{line: 26, col: 33, offset: 2273},
+																															val:        "[_a-zA-Z0-9]",
+																															chars:      []rune{'_'},
+																															ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																															ignoreCase: false,
+																															inverted:   false,
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																			&labeledExpr{
+																				pos:   position{line: 56, col: 40, offset: 4704},
+																				label: "tail",
+																				expr: &zeroOrMoreExpr{
+																					pos: position{line: 56, col: 45, offset: 4709},
+																					expr: &seqExpr{
+																						pos: position{line: 56, col: 46, offset: 4710},
+																						exprs: []any{
+																							&zeroOrMoreExpr{
+																								pos: position{line: 65, col: 24, offset: 5441},
+																								expr: &charClassMatcher{
+																									pos:        position{line: 65, col: 24, offset: 5441},
+																									val:        "[ \\t\\r\\n]",
+																									chars:      []rune{' ', '\t', '\r', '\n'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																							},
+																							&litMatcher{
+																								pos:        position{line: 56, col: 48, offset: 4712},
+																								val:        ",",
+																								ignoreCase: false,
+																								want:       "\",\"",
+																							},
+																							&zeroOrMoreExpr{
+																								pos: position{line: 65, col: 24, offset: 5441},
+																								expr: &charClassMatcher{
+																									pos:        position{line: 65, col: 24, offset: 5441},
+																									val:        "[ \\t\\r\\n]",
+																									chars:      []rune{' ', '\t', '\r', '\n'},
+																									ignoreCase: false,
+																									inverted:   false,
+																								},
+																							},
+																							&choiceExpr{
+																								pos: position{line: 23, col: 24, offset: 1899},
+																								alternatives: []any{
+																									&actionExpr{
+																										pos: position{line: 45, col: 24, offset: 3927},
+																										run: (*parser).callonPrimary985,
+																										expr: &seqExpr{
+																											pos: position{line: 45, col: 24, offset: 3927},
+																											exprs: []any{
+																												&litMatcher{
+																													pos:        position{line: 45, col: 24, offset: 3927},
+																													val:        "\"",
+																													ignoreCase: false,
+																													want:       "\"\\\"\"",
+																												},
+																												&zeroOrMoreExpr{
+																													pos: position{line: 46, col: 24, offset: 4030},
+																													expr: &choiceExpr{
+																														pos: position{line: 46, col: 26, offset: 4032},
+																														alternatives: []any{
+																															&seqExpr{
+																																pos: position{line: 46, col: 26, offset: 4032},
+																																exprs: []any{
+																																	&notExpr{
+																																		pos: position{line: 46, col: 26, offset: 4032},
+																																		expr: &charClassMatcher{
+																																			pos:        position{line: 47, col: 24, offset: 4095},
+																																			val:        "[\"\\\\\\x00-\\x1f]",
+																																			chars:      []rune{'"', '\\'},
+																																			ranges:     []rune{'\x00', '\x1f'},
+																																			ignoreCase: false,
+																																			inverted:   false,
+																																		},
+																																	},
+																																	&anyMatcher{
+																																		line: 46, col: 39, offset: 4045,
+																																	},
+																																},
+																															},
+																															&seqExpr{
+																																pos: position{line: 46, col: 43, offset: 4049},
+																																exprs: []any{
+																																	&litMatcher{
+																																		pos:        position{line: 46, col: 43, offset: 4049},
+																																		val:        "\\",
+																																		ignoreCase: false,
+																																		want:       "\"\\\\\"",
+																																	},
+																																	&choiceExpr{
+																																		pos: position{line: 48, col: 24, offset: 4133},
+																																		alternatives: []any{
+																																			&charClassMatcher{
+																																				pos:        position{line: 49, col: 24, offset: 4189},
+																																				val:        "[\"\\\\/bfnrt]",
+																																				chars:      []rune{'"', '\\', '/', 'b', 'f', 'n', 'r', 't'},
+																																				ignoreCase: false,
+																																				inverted:   false,
+																																			},
+																																			&seqExpr{
+																																				pos: position{line: 50, col: 24, offset: 4224},
+																																				exprs: []any{
+																																					&litMatcher{
+																																						pos:        position{line: 50, col: 24, offset: 4224},
+																																						val:        "u",
+																																						ignoreCase: false,
+																																						want:       "\"u\"",
+																																					},
+																																					&charClassMatcher{
+																																						pos:        position{line: 51, col: 24, offset: 4287},
+																																						val:        "[0-9a-f]i",
+																																						ranges:     []rune{'0', '9', 'a', 'f'},
+																																						ignoreCase: true,
+																																						inverted:   false,
+																																					},
+																																					&charClassMatcher{
+																																						pos:        position{line: 51, col: 24, offset: 4287},
+																																						val:        "[0-9a-f]i",
+																																						ranges:     []rune{'0', '9', 'a', 'f'},
+																																						ignoreCase: true,
+																																						inverted:   false,
+																																					},
+																																					&charClassMatcher{
+																																						pos:        position{line: 51, col: 24, offset: 4287},
+																																						val:        "[0-9a-f]i",
+																																						ranges:     []rune{'0', '9', 'a', 'f'},
+																																						ignoreCase: true,
+																																						inverted:   false,
+																																					},
+																																					&charClassMatcher{
+																																						pos:        position{line: 51, col: 24, offset: 4287},
+																																						val:        "[0-9a-f]i",
+																																						ranges:     []rune{'0', '9', 'a', 'f'},
+																																						ignoreCase: true,
+																																						inverted:   false,
+																																					},
+																																				},
+																																			},
+																																		},
+																																	},
+																																},
+																															},
+																														},
+																													},
+																												},
+																												&litMatcher{
+																													pos:        position{line: 45, col: 40, offset: 3943},
+																													val:        "\"",
+																													ignoreCase: false,
+																													want:       "\"\\\"\"",
+																												},
+																											},
+																										},
+																									},
+																									&actionExpr{
+																										pos: position{line: 33, col: 24, offset: 2791},
+																										run: (*parser).callonPrimary1005,
+																										expr: &seqExpr{
+																											pos: position{line: 33, col: 24, offset: 2791},
+																											exprs: []any{
+																												&charClassMatcher{
+																													pos:        position{line: 32, col: 24, offset: 2762},
+																													val:        "[0-9]",
+																													ranges:     []rune{'0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 32, col: 24, offset: 2762},
+																													val:        "[0-9]",
+																													ranges:     []rune{'0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 32, col: 24, offset: 2762},
+																													val:        "[0-9]",
+																													ranges:     []rune{'0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 32, col: 24, offset: 2762},
+																													val:        "[0-9]",
+																													ranges:     []rune{'0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&litMatcher{
+																													pos:        position{line: 34, col: 76, offset: 2948},
+																													val:        "-",
+																													ignoreCase: false,
+																													want:       "\"-\"",
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 32, col: 24, offset: 2762},
+																													val:        "[0-9]",
+																													ranges:     []rune{'0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 32, col: 24, offset: 2762},
+																													val:        "[0-9]",
+																													ranges:     []rune{'0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&litMatcher{
+																													pos:        position{line: 34, col: 106, offset: 2978},
+																													val:        "-",
+																													ignoreCase: false,
+																													want:       "\"-\"",
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 32, col: 24, offset: 2762},
+																													val:        "[0-9]",
+																													ranges:     []rune{'0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&charClassMatcher{
+																													pos:        position{line: 32, col: 24, offset: 2762},
+																													val:        "[0-9]",
+																													ranges:     []rune{'0', '9'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&zeroOrOneExpr{
+																													pos: position{line: 33, col: 29, offset: 2796},
+																													expr: &seqExpr{
+																														pos: position{line: 33, col: 31, offset: 2798},
+																														exprs: []any{
+																															&charClassMatcher{
+																																pos:        position{line: 33, col: 31, offset: 2798},
+																																val:        "[Tt]",
+																																chars:      []rune{'T', 't'},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																															&charClassMatcher{
+																																pos:        position{line: 32, col: 24, offset: 2762},
+																																val:        "[0-9]",
+																																ranges:     []rune{'0', '9'},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																															&charClassMatcher{
+																																pos:        position{line: 32, col: 24, offset: 2762},
+																																val:        "[0-9]",
+																																ranges:     []rune{'0', '9'},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																															&litMatcher{
+																																pos:        position{line: 35, col: 50, offset: 3057},
+																																val:        ":",
+																																ignoreCase: false,
+																																want:       "\":\"",
+																															},
+																															&charClassMatcher{
+																																pos:        position{line: 32, col: 24, offset: 2762},
+																																val:        "[0-9]",
+																																ranges:     []rune{'0', '9'},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																															&charClassMatcher{
+																																pos:        position{line: 32, col: 24, offset: 2762},
+																																val:        "[0-9]",
+																																ranges:     []rune{'0', '9'},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																															&litMatcher{
+																																pos:        position{line: 35, col: 80, offset: 3087},
+																																val:        ":",
+																																ignoreCase: false,
+																																want:       "\":\"",
+																															},
+																															&charClassMatcher{
+																																pos:        position{line: 32, col: 24, offset: 2762},
+																																val:        "[0-9]",
+																																ranges:     []rune{'0', '9'},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																															&charClassMatcher{
+																																pos:        position{line: 32, col: 24, offset: 2762},
+																																val:        "[0-9]",
+																																ranges:     []rune{'0', '9'},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																															&zeroOrOneExpr{
+																																pos: position{line: 35, col: 110, offset: 3117},
+																																expr: &seqExpr{
+																																	pos: position{line: 35, col: 112, offset: 3119},
+																																	exprs: []any{
+																																		&litMatcher{
+																																			pos:        position{line: 35, col: 112, offset: 3119},
+																																			val:        ".",
+																																			ignoreCase: false,
+																																			want:       "\".\"",
+																																		},
+																																		&oneOrMoreExpr{
+																																			pos: position{line: 35, col: 116, offset: 3123},
+																																			expr: &charClassMatcher{
+																																				pos:        position{line: 32, col: 24, offset: 2762},
+																																				val:        "[0-9]",
+																																				ranges:     []rune{'0', '9'},
+																																				ignoreCase: false,
+																																				inverted:   false,
+																																			},
+																																		},
+																																	},
+																																},
+																															},
+																															&choiceExpr{
+																																pos: position{line: 36, col: 24, offset: 3172},
+																																alternatives: []any{
+																																	&charClassMatcher{
+																																		pos:        position{line: 36, col: 24, offset: 3172},
+																																		val:        "[Zz]",
+																																		chars:      []rune{'Z', 'z'},
+																																		ignoreCase: false,
+																																		inverted:   false,
+																																	},
+																																	&seqExpr{
+																																		pos: position{line: 36, col: 31, offset: 3179},
+																																		exprs: []any{
+																																			&charClassMatcher{
+																																				pos:        position{line: 36, col: 31, offset: 3179},
+																																				val:        "[+-]",
+																																				chars:      []rune{'+', '-'},
+																																				ignoreCase: false,
+																																				inverted:   false,
+																																			},
+																																			&charClassMatcher{
+																																				pos:        position{line: 32, col: 24, offset: 2762},
+																																				val:        "[0-9]",
+																																				ranges:     []rune{'0', '9'},
+																																				ignoreCase: false,
+																																				inverted:   false,
+																																			},
+																																			&charClassMatcher{
+																																				pos:        position{line: 32, col: 24, offset: 2762},
+																																				val:        "[0-9]",
+																																				ranges:     []rune{'0', '9'},
+																																				ignoreCase: false,
+																																				inverted:   false,
+																																			},
+																																			&litMatcher{
+																																				pos:        position{line: 36, col: 62, offset: 3210},
+																																				val:        ":",
+																																				ignoreCase: false,
+																																				want:       "\":\"",
+																																			},
+																																			&charClassMatcher{
+																																				pos:        position{line: 32, col: 24, offset: 2762},
+																																				val:        "[0-9]",
+																																				ranges:     []rune{'0', '9'},
+																																				ignoreCase: false,
+																																				inverted:   false,
+																																			},
+																																			&charClassMatcher{
+																																				pos:        position{line: 32, col: 24, offset: 2762},
+																																				val:        "[0-9]",
+																																				ranges:     []rune{'0', '9'},
+																																				ignoreCase: false,
+																																				inverted:   false,
+																																			},
+																																		},
+																																	},
+																																},
+																															},
+																														},
+																													},
+																												},
+																												&notExpr{
+																													pos: position{line: 27, col: 24, offset: 2310},
+																													expr: &charClassMatcher{
+																														pos:        position{line: 27, col: 25, offset: 2311},
+																														val:        "[_a-zA-Z0-9]",
+																														chars:      []rune{'_'},
+																														ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																												},
+																												&notExpr{
+																													pos: position{line: 27, col: 38, offset: 2324},
+																													expr: &seqExpr{
+																														pos: position{line: 27, col: 41, offset: 2327},
+																														exprs: []any{
+																															&litMatcher{
+																																pos:        position{line: 27, col: 41, offset: 2327},
+																																val:        ".",
+																																ignoreCase: false,
+																																want:       "\".\"",
+																															},
+																															&charClassMatcher{
+																																pos:        position{line: 27, col: 45, offset: 2331},
+																																val:        "[_a-zA-Z0-9]",
+																																chars:      []rune{'_'},
+																																ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																									&actionExpr{
+																										pos: position{line: 37, col: 24, offset: 3263},
+																										run: (*parser).callonPrimary1048,
+																										expr: &seqExpr{
+																											pos: position{line: 37, col: 24, offset: 3263},
+																											exprs: []any{
+																												&litMatcher{
+																													pos:        position{line: 37, col: 24, offset: 3263},
+																													val:        "now",
+																													ignoreCase: false,
+																													want:       "\"now\"",
+																												},
+																												&zeroOrOneExpr{
+																													pos: position{line: 37, col: 30, offset: 3269},
+																													expr: &seqExpr{
+																														pos: position{line: 37, col: 32, offset: 3271},
+																														exprs: []any{
+																															&charClassMatcher{
+																																pos:        position{line: 37, col: 32, offset: 3271},
+																																val:        "[+-]",
+																																chars:      []rune{'+', '-'},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																															&oneOrMoreExpr{
+																																pos: position{line: 39, col: 24, offset: 3477},
+																																expr: &seqExpr{
+																																	pos: position{line: 39, col: 26, offset: 3479},
+																																	exprs: []any{
+																																		&oneOrMoreExpr{
+																																			pos: position{line: 39, col: 26, offset: 3479},
+																																			expr: &charClassMatcher{
+																																				pos:        position{line: 32, col: 24, offset: 2762},
+																																				val:        "[0-9]",
+																																				ranges:     []rune{'0', '9'},
+																																				ignoreCase: false,
+																																				inverted:   false,
+																																			},
+																																		},
+																																		&choiceExpr{
+																																			pos: position{line: 40, col: 24, offset: 3532},
+																																			alternatives: []any{
+																																				&litMatcher{
+																																					pos:        position{line: 40, col: 24, offset: 3532},
+																																					val:        "ns",
+																																					ignoreCase: false,
+																																					want:       "\"ns\"",
+																																				},
+																																				&litMatcher{
+																																					pos:        position{line: 40, col: 31, offset: 3539},
+																																					val:        "us",
+																																					ignoreCase: false,
+																																					want:       "\"us\"",
+																																				},
+																																				&litMatcher{
+																																					pos:        position{line: 40, col: 38, offset: 3546},
+																																					val:        "ms",
+																																					ignoreCase: false,
+																																					want:       "\"ms\"",
+																																				},
+																																				&charClassMatcher{
+																																					pos:        position{line: 40, col: 45, offset: 3553},
+																																					val:        "[smhdw]",
+																																					chars:      []rune{'s', 'm', 'h', 'd', 'w'},
+																																					ignoreCase: false,
+																																					inverted:   false,
+																																				},
+																																			},
+																																		},
+																																	},
+																																},
+																															},
+																														},
+																													},
+																												},
+																												&notExpr{
+																													pos: position{line: 27, col: 24, offset: 2310},
+																													expr: &charClassMatcher{
+																														pos:        position{line: 27, col: 25, offset: 2311},
+																														val:        "[_a-zA-Z0-9]",
+																														chars:      []rune{'_'},
+																														ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																												},
+																												&notExpr{
+																													pos: position{line: 27, col: 38, offset: 2324},
+																													expr: &seqExpr{
+																														pos: position{line: 27, col: 41, offset: 2327},
+																														exprs: []any{
+																															&litMatcher{
+																																pos:        position{line: 27, col: 41, offset: 2327},
+																																val:        ".",
+																																ignoreCase: false,
+																																want:       "\".\"",
+																															},
+																															&charClassMatcher{
+																																pos:        position{line: 27, col: 45, offset: 2331},
+																																val:        "[_a-zA-Z0-9]",
+																																chars:      []rune{'_'},
+																																ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																									&actionExpr{
+																										pos: position{line: 38, col: 24, offset: 3372},
+																										run: (*parser).callonPrimary1069,
+																										expr: &seqExpr{
+																											pos: position{line: 38, col: 24, offset: 3372},
+																											exprs: []any{
+																												&zeroOrOneExpr{
+																													pos: position{line: 38, col: 24, offset: 3372},
+																													expr: &litMatcher{
+																														pos:        position{line: 38, col: 24, offset: 3372},
+																														val:        "-",
+																														ignoreCase: false,
+																														want:       "\"-\"",
+																													},
+																												},
+																												&oneOrMoreExpr{
+																													pos: position{line: 39, col: 24, offset: 3477},
+																													expr: &seqExpr{
+																														pos: position{line: 39, col: 26, offset: 3479},
+																														exprs: []any{
+																															&oneOrMoreExpr{
+																																pos: position{line: 39, col: 26, offset: 3479},
+																																expr: &charClassMatcher{
+																																	pos:        position{line: 32, col: 24, offset: 2762},
+																																	val:        "[0-9]",
+																																	ranges:     []rune{'0', '9'},
+																																	ignoreCase: false,
+																																	inverted:   false,
+																																},
+																															},
+																															&choiceExpr{
+																																pos: position{line: 40, col: 24, offset: 3532},
+																																alternatives: []any{
+																																	&litMatcher{
+																																		pos:        position{line: 40, col: 24, offset: 3532},
+																																		val:        "ns",
+																																		ignoreCase: false,
+																																		want:       "\"ns\"",
+																																	},
+																																	&litMatcher{
+																																		pos:        position{line: 40, col: 31, offset: 3539},
+																																		val:        "us",
+																																		ignoreCase: false,
+																																		want:       "\"us\"",
+																																	},
+																																	&litMatcher{
+																																		pos:        position{line: 40, col: 38, offset: 3546},
+																																		val:        "ms",
+																																		ignoreCase: false,
+																																		want:       "\"ms\"",
+																																	},
+																																	&charClassMatcher{
+																																		pos:        position{line: 40, col: 45, offset: 3553},
+																																		val:        "[smhdw]",
+																																		chars:      []rune{'s', 'm', 'h', 'd', 'w'},
+																																		ignoreCase: false,
+																																		inverted:   false,
+																																	},
+																																},
+																															},
+																														},
+																													},
+																												},
+																												&notExpr{
+																													pos: position{line: 27, col: 24, offset: 2310},
+																													expr: &charClassMatcher{
+																														pos:        position{line: 27, col: 25, offset: 2311},
+																														val:        "[_a-zA-Z0-9]",
+																														chars:      []rune{'_'},
+																														ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																												},
+																												&notExpr{
+																													pos: position{line: 27, col: 38, offset: 2324},
+																													expr: &seqExpr{
+																														pos: position{line: 27, col: 41, offset: 2327},
+																														exprs: []any{
+																															&litMatcher{
+																																pos:        position{line: 27, col: 41, offset: 2327},
+																																val:        ".",
+																																ignoreCase: false,
+																																want:       "\".\"",
+																															},
+																															&charClassMatcher{
+																																pos:        position{line: 27, col: 45, offset: 2331},
+																																val:        "[_a-zA-Z0-9]",
+																																chars:      []rune{'_'},
+																																ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																									&actionExpr{
+																										pos: position{line: 31, col: 24, offset: 2659},
+																										run: (*parser).callonPrimary1088,
+																										expr: &seqExpr{
+																											pos: position{line: 31, col: 24, offset: 2659},
+																											exprs: []any{
+																												&zeroOrOneExpr{
+																													pos: position{line: 31, col: 24, offset: 2659},
+																													expr: &litMatcher{
+																														pos:        position{line: 31, col: 24, offset: 2659},
+																														val:        "-",
+																														ignoreCase: false,
+																														want:       "\"-\"",
+																													},
+																												},
+																												&choiceExpr{
+																													pos: position{line: 30, col: 24, offset: 2596},
+																													alternatives: []any{
+																														&litMatcher{
+																															pos:        position{line: 30, col: 24, offset: 2596},
+																															val:        "0",
+																															ignoreCase: false,
+																															want:       "\"0\"",
+																														},
+																														&seqExpr{
+																															pos: position{line: 30, col: 30, offset: 2602},
+																															exprs: []any{
+																																&charClassMatcher{
+																																	pos:        position{line: 41, col: 24, offset: 3604},
+																																	val:        "[1-9]",
+																																	ranges:     []rune{'1', '9'},
+																																	ignoreCase: false,
+																																	inverted:   false,
+																																},
+																																&zeroOrMoreExpr{
+																																	pos: position{line: 30, col: 50, offset: 2622},
+																																	expr: &charClassMatcher{
+																																		pos:        position{line: 32, col: 24, offset: 2762},
+																																		val:        "[0-9]",
+																																		ranges:     []rune{'0', '9'},
+																																		ignoreCase: false,
+																																		inverted:   false,
+																																	},
+																																},
+																															},
+																														},
+																													},
+																												},
+																												&zeroOrOneExpr{
+																													pos: position{line: 31, col: 37, offset: 2672},
+																													expr: &seqExpr{
+																														pos: position{line: 31, col: 39, offset: 2674},
+																														exprs: []any{
+																															&litMatcher{
+																																pos:        position{line: 31, col: 39, offset: 2674},
+																																val:        ".",
+																																ignoreCase: false,
+																																want:       "\".\"",
+																															},
+																															&oneOrMoreExpr{
+																																pos: position{line: 31, col: 43, offset: 2678},
+																																expr: &charClassMatcher{
+																																	pos:        position{line: 32, col: 24, offset: 2762},
+																																	val:        "[0-9]",
+																																	ranges:     []rune{'0', '9'},
+																																	ignoreCase: false,
+																																	inverted:   false,
+																																},
+																															},
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																									&actionExpr{
+																										pos: position{line: 28, col: 24, offset: 2369},
+																										run: (*parser).callonPrimary1103,
+																										expr: &seqExpr{
+																											pos: position{line: 28, col: 24, offset: 2369},
+																											exprs: []any{
+																												&choiceExpr{
+																													pos: position{line: 28, col: 26, offset: 2371},
+																													alternatives: []any{
+																														&litMatcher{
+																															pos:        position{line: 28, col: 26, offset: 2371},
+																															val:        "true",
+																															ignoreCase: false,
+																															want:       "\"true\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 28, col: 35, offset: 2380},
+																															val:        "TRUE",
+																															ignoreCase: false,
+																															want:       "\"TRUE\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 28, col: 44, offset: 2389},
+																															val:        "false",
+																															ignoreCase: false,
+																															want:       "\"false\"",
+																														},
+																														&litMatcher{
+																															pos:        position{line: 28, col: 54, offset: 2399},
+																															val:        "FALSE",
+																															ignoreCase: false,
+																															want:       "\"FALSE\"",
+																														},
+																													},
+																												},
+																												&notExpr{
+																													pos: position{line: 27, col: 24, offset: 2310},
+																													expr: &charClassMatcher{
+																														pos:        position{line: 27, col: 25, offset: 2311},
+																														val:        "[_a-zA-Z0-9]",
+																														chars:      []rune{'_'},
+																														ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																												},
+																												&notExpr{
+																													pos: position{line: 27, col: 38, offset: 2324},
+																													expr: &seqExpr{
+																														pos: position{line: 27, col: 41, offset: 2327},
+																														exprs: []any{
+																															&litMatcher{
+																																pos:        position{line: 27, col: 41, offset: 2327},
+																																val:        ".",
+																																ignoreCase: false,
+																																want:       "\".\"",
+																															},
+																															&charClassMatcher{
+																																pos:        position{line: 27, col: 45, offset: 2331},
+																																val:        "[_a-zA-Z0-9]",
+																																chars:      []rune{'_'},
+																																ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																									&actionExpr{
+																										pos: position{line: 24, col: 24, offset: 1998},
+																										run: (*parser).callonPrimary1116,
+																										expr: &actionExpr{
+																											pos: position{line: 25, col: 24, offset: 2152},
+																											run: (*parser).callonPrimary1117,
+																											expr: &seqExpr{
+																												pos: position{line: 25, col: 24, offset: 2152},
+																												exprs: []any{
+																													&charClassMatcher{
+																														pos:        position{line: 26, col: 24, offset: 2264},
+																														val:        "[_a-zA-Z]",
+																														chars:      []rune{'_'},
+																														ranges:     []rune{'a', 'z', 'A', 'Z'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																													&zeroOrMoreExpr{
+																														pos: position{line: 26, col: 33, offset: 2273},
+																														expr: &charClassMatcher{
+																															pos:        position{line: 26, col: 33, offset: 2273},
+																															val:        "[_a-zA-Z0-9]",
+																															chars:      []rune{'_'},
+																															ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																															ignoreCase: false,
+																															inverted:   false,
+																														},
+																													},
+																													&zeroOrMoreExpr{
+																														pos: position{line: 25, col: 37, offset: 2165},
+																														expr: &seqExpr{
+																															pos: position{line: 25, col: 38, offset: 2166},
+																															exprs: []any{
+																																&litMatcher{
+																																	pos:        position{line: 25, col: 38, offset: 2166},
+																																	val:        ".",
+																																	ignoreCase: false,
+																																	want:       "\".\"",
+																																},
+																																&charClassMatcher{
+																																	pos:        position{line: 26, col: 24, offset: 2264},
+																																	val:        "[_a-zA-Z]",
+																																	chars:      []rune{'_'},
+																																	ranges:     []rune{'a', 'z', 'A', 'Z'},
+																																	ignoreCase: false,
+																																	inverted:   false,
+																																},
+																																&zeroOrMoreExpr{
+																																	pos: position{line: 26, col: 33, offset: 2273},
+																																	expr: &charClassMatcher{
+																																		pos:        position{line: 26, col: 33, offset: 2273},
+																																		val:        "[_a-zA-Z0-9]",
+																																		chars:      []rune{'_'},
+																																		ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																																		ignoreCase: false,
+																																		inverted:   false,
+																																	},
+																																},
+																															},
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+														&zeroOrMoreExpr{
+															pos: position{line: 65, col: 24, offset: 5441},
+															expr: &charClassMatcher{
+																pos:        position{line: 65, col: 24, offset: 5441},
+																val:        "[ \\t\\r\\n]",
+																chars:      []rune{' ', '\t', '\r', '\n'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 55, col: 54, offset: 4598},
+															val:        "]",
+															ignoreCase: false,
+															want:       "\"]\"",
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 45, col: 24, offset: 3927},
+												run: (*parser).callonPrimary1131,
+												expr: &seqExpr{
+													pos: position{line: 45, col: 24, offset: 3927},
+													exprs: []any{
+														&litMatcher{
+															pos:        position{line: 45, col: 24, offset: 3927},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+														&zeroOrMoreExpr{
+															pos: position{line: 46, col: 24, offset: 4030},
+															expr: &choiceExpr{
+																pos: position{line: 46, col: 26, offset: 4032},
+																alternatives: []any{
+																	&seqExpr{
+																		pos: position{line: 46, col: 26, offset: 4032},
+																		exprs: []any{
+																			&notExpr{
+																				pos: position{line: 46, col: 26, offset: 4032},
+																				expr: &charClassMatcher{
+																					pos:        position{line: 47, col: 24, offset: 4095},
+																					val:        "[\"\\\\\\x00-\\x1f]",
+																					chars:      []rune{'"', '\\'},
+																					ranges:     []rune{'\x00', '\x1f'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																			},
+																			&anyMatcher{
+																				line: 46, col: 39, offset: 4045,
+																			},
+																		},
+																	},
+																	&seqExpr{
+																		pos: position{line: 46, col: 43, offset: 4049},
+																		exprs: []any{
+																			&litMatcher{
+																				pos:        position{line: 46, col: 43, offset: 4049},
+																				val:        "\\",
+																				ignoreCase: false,
+																				want:       "\"\\\\\"",
+																			},
+																			&choiceExpr{
+																				pos: position{line: 48, col: 24, offset: 4133},
+																				alternatives: []any{
+																					&charClassMatcher{
+																						pos:        position{line: 49, col: 24, offset: 4189},
+																						val:        "[\"\\\\/bfnrt]",
+																						chars:      []rune{'"', '\\', '/', 'b', 'f', 'n', 'r', 't'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																					&seqExpr{
+																						pos: position{line: 50, col: 24, offset: 4224},
+																						exprs: []any{
+																							&litMatcher{
+																								pos:        position{line: 50, col: 24, offset: 4224},
+																								val:        "u",
+																								ignoreCase: false,
+																								want:       "\"u\"",
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 51, col: 24, offset: 4287},
+																								val:        "[0-9a-f]i",
+																								ranges:     []rune{'0', '9', 'a', 'f'},
+																								ignoreCase: true,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 51, col: 24, offset: 4287},
+																								val:        "[0-9a-f]i",
+																								ranges:     []rune{'0', '9', 'a', 'f'},
+																								ignoreCase: true,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 51, col: 24, offset: 4287},
+																								val:        "[0-9a-f]i",
+																								ranges:     []rune{'0', '9', 'a', 'f'},
+																								ignoreCase: true,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 51, col: 24, offset: 4287},
+																								val:        "[0-9a-f]i",
+																								ranges:     []rune{'0', '9', 'a', 'f'},
+																								ignoreCase: true,
+																								inverted:   false,
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 45, col: 40, offset: 3943},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 44, col: 24, offset: 3825},
+												run: (*parser).callonPrimary1151,
+												expr: &seqExpr{
+													pos: position{line: 44, col: 24, offset: 3825},
+													exprs: []any{
+														&litMatcher{
+															pos:        position{line: 44, col: 24, offset: 3825},
+															val:        "/",
+															ignoreCase: false,
+															want:       "\"/\"",
+														},
+														&zeroOrMoreExpr{
+															pos: position{line: 44, col: 28, offset: 3829},
+															expr: &choiceExpr{
+																pos: position{line: 44, col: 30, offset: 3831},
+																alternatives: []any{
+																	&seqExpr{
+																		pos: position{line: 44, col: 30, offset: 3831},
+																		exprs: []any{
+																			&litMatcher{
+																				pos:        position{line: 44, col: 30, offset: 3831},
+																				val:        "\\",
+																				ignoreCase: false,
+																				want:       "\"\\\\\"",
+																			},
+																			&anyMatcher{
+																				line: 44, col: 35, offset: 3836,
+																			},
+																		},
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 44, col: 39, offset: 3840},
+																		val:        "[^/\\\\\\n]",
+																		chars:      []rune{'/', '\\', '\n'},
+																		ignoreCase: false,
+																		inverted:   true,
+																	},
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 44, col: 51, offset: 3852},
+															val:        "/",
+															ignoreCase: false,
+															want:       "\"/\"",
+														},
+														&zeroOrMoreExpr{
+															pos: position{line: 44, col: 55, offset: 3856},
+															expr: &charClassMatcher{
+																pos:        position{line: 44, col: 55, offset: 3856},
+																val:        "[a-z]",
+																ranges:     []rune{'a', 'z'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 33, col: 24, offset: 2791},
+												run: (*parser).callonPrimary1163,
+												expr: &seqExpr{
+													pos: position{line: 33, col: 24, offset: 2791},
+													exprs: []any{
+														&charClassMatcher{
+															pos:        position{line: 32, col: 24, offset: 2762},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+														&charClassMatcher{
+															pos:        position{line: 32, col: 24, offset: 2762},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+														&charClassMatcher{
+															pos:        position{line: 32, col: 24, offset: 2762},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+														&charClassMatcher{
+															pos:        position{line: 32, col: 24, offset: 2762},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+														&litMatcher{
+															pos:        position{line: 34, col: 76, offset: 2948},
+															val:        "-",
+															ignoreCase: false,
+															want:       "\"-\"",
+														},
+														&charClassMatcher{
+															pos:        position{line: 32, col: 24, offset: 2762},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+														&charClassMatcher{
+															pos:        position{line: 32, col: 24, offset: 2762},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+														&litMatcher{
+															pos:        position{line: 34, col: 106, offset: 2978},
+															val:        "-",
+															ignoreCase: false,
+															want:       "\"-\"",
+														},
+														&charClassMatcher{
+															pos:        position{line: 32, col: 24, offset: 2762},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+														&charClassMatcher{
+															pos:        position{line: 32, col: 24, offset: 2762},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+														&zeroOrOneExpr{
+															pos: position{line: 33, col: 29, offset: 2796},
+															expr: &seqExpr{
+																pos: position{line: 33, col: 31, offset: 2798},
+																exprs: []any{
+																	&charClassMatcher{
+																		pos:        position{line: 33, col: 31, offset: 2798},
+																		val:        "[Tt]",
+																		chars:      []rune{'T', 't'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 32, col: 24, offset: 2762},
+																		val:        "[0-9]",
+																		ranges:     []rune{'0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 32, col: 24, offset: 2762},
+																		val:        "[0-9]",
+																		ranges:     []rune{'0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																	&litMatcher{
+																		pos:        position{line: 35, col: 50, offset: 3057},
+																		val:        ":",
+																		ignoreCase: false,
+																		want:       "\":\"",
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 32, col: 24, offset: 2762},
+																		val:        "[0-9]",
+																		ranges:     []rune{'0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 32, col: 24, offset: 2762},
+																		val:        "[0-9]",
+																		ranges:     []rune{'0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																	&litMatcher{
+																		pos:        position{line: 35, col: 80, offset: 3087},
+																		val:        ":",
+																		ignoreCase: false,
+																		want:       "\":\"",
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 32, col: 24, offset: 2762},
+																		val:        "[0-9]",
+																		ranges:     []rune{'0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 32, col: 24, offset: 2762},
+																		val:        "[0-9]",
+																		ranges:     []rune{'0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																	&zeroOrOneExpr{
+																		pos: position{line: 35, col: 110, offset: 3117},
+																		expr: &seqExpr{
+																			pos: position{line: 35, col: 112, offset: 3119},
+																			exprs: []any{
+																				&litMatcher{
+																					pos:        position{line: 35, col: 112, offset: 3119},
+																					val:        ".",
+																					ignoreCase: false,
+																					want:       "\".\"",
+																				},
+																				&oneOrMoreExpr{
+																					pos: position{line: 35, col: 116, offset: 3123},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 32, col: 24, offset: 2762},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																	&choiceExpr{
+																		pos: position{line: 36, col: 24, offset: 3172},
+																		alternatives: []any{
+																			&charClassMatcher{
+																				pos:        position{line: 36, col: 24, offset: 3172},
+																				val:        "[Zz]",
+																				chars:      []rune{'Z', 'z'},
+																				ignoreCase: false,
+																				inverted:   false,
+																			},
+																			&seqExpr{
+																				pos: position{line: 36, col: 31, offset: 3179},
+																				exprs: []any{
+																					&charClassMatcher{
+																						pos:        position{line: 36, col: 31, offset: 3179},
+																						val:        "[+-]",
+																						chars:      []rune{'+', '-'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																					&charClassMatcher{
+																						pos:        position{line: 32, col: 24, offset: 2762},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																					&charClassMatcher{
+																						pos:        position{line: 32, col: 24, offset: 2762},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																					&litMatcher{
+																						pos:        position{line: 36, col: 62, offset: 3210},
+																						val:        ":",
+																						ignoreCase: false,
+																						want:       "\":\"",
+																					},
+																					&charClassMatcher{
+																						pos:        position{line: 32, col: 24, offset: 2762},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																					&charClassMatcher{
+																						pos:        position{line: 32, col: 24, offset: 2762},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+														&notExpr{
+															pos: position{line: 27, col: 24, offset: 2310},
+															expr: &charClassMatcher{
+																pos:        position{line: 27, col: 25, offset: 2311},
+																val:        "[_a-zA-Z0-9]",
+																chars:      []rune{'_'},
+																ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+														&notExpr{
+															pos: position{line: 27, col: 38, offset: 2324},
+															expr: &seqExpr{
+																pos: position{line: 27, col: 41, offset: 2327},
+																exprs: []any{
+																	&litMatcher{
+																		pos:        position{line: 27, col: 41, offset: 2327},
+																		val:        ".",
+																		ignoreCase: false,
+																		want:       "\".\"",
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 27, col: 45, offset: 2331},
+																		val:        "[_a-zA-Z0-9]",
+																		chars:      []rune{'_'},
+																		ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 37, col: 24, offset: 3263},
+												run: (*parser).callonPrimary1206,
+												expr: &seqExpr{
+													pos: position{line: 37, col: 24, offset: 3263},
+													exprs: []any{
+														&litMatcher{
+															pos:        position{line: 37, col: 24, offset: 3263},
+															val:        "now",
+															ignoreCase: false,
+															want:       "\"now\"",
+														},
+														&zeroOrOneExpr{
+															pos: position{line: 37, col: 30, offset: 3269},
+															expr: &seqExpr{
+																pos: position{line: 37, col: 32, offset: 3271},
+																exprs: []any{
+																	&charClassMatcher{
+																		pos:        position{line: 37, col: 32, offset: 3271},
+																		val:        "[+-]",
+																		chars:      []rune{'+', '-'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																	&oneOrMoreExpr{
+																		pos: position{line: 39, col: 24, offset: 3477},
+																		expr: &seqExpr{
+																			pos: position{line: 39, col: 26, offset: 3479},
+																			exprs: []any{
+																				&oneOrMoreExpr{
+																					pos: position{line: 39, col: 26, offset: 3479},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 32, col: 24, offset: 2762},
+																						val:        "[0-9]",
+																						ranges:     []rune{'0', '9'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&choiceExpr{
+																					pos: position{line: 40, col: 24, offset: 3532},
+																					alternatives: []any{
+																						&litMatcher{
+																							pos:        position{line: 40, col: 24, offset: 3532},
+																							val:        "ns",
+																							ignoreCase: false,
+																							want:       "\"ns\"",
+																						},
+																						&litMatcher{
+																							pos:        position{line: 40, col: 31, offset: 3539},
+																							val:        "us",
+																							ignoreCase: false,
+																							want:       "\"us\"",
+																						},
+																						&litMatcher{
+																							pos:        position{line: 40, col: 38, offset: 3546},
+																							val:        "ms",
+																							ignoreCase: false,
+																							want:       "\"ms\"",
+																						},
+																						&charClassMatcher{
+																							pos:        position{line: 40, col: 45, offset: 3553},
+																							val:        "[smhdw]",
+																							chars:      []rune{'s', 'm', 'h', 'd', 'w'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+														&notExpr{
+															pos: position{line: 27, col: 24, offset: 2310},
+															expr: &charClassMatcher{
+																pos:        position{line: 27, col: 25, offset: 2311},
+																val:        "[_a-zA-Z0-9]",
+																chars:      []rune{'_'},
+																ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+														&notExpr{
+															pos: position{line: 27, col: 38, offset: 2324},
+															expr: &seqExpr{
+																pos: position{line: 27, col: 41, offset: 2327},
+																exprs: []any{
+																	&litMatcher{
+																		pos:        position{line: 27, col: 41, offset: 2327},
+																		val:        ".",
+																		ignoreCase: false,
+																		want:       "\".\"",
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 27, col: 45, offset: 2331},
+																		val:        "[_a-zA-Z0-9]",
+																		chars:      []rune{'_'},
+																		ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 38, col: 24, offset: 3372},
+												run: (*parser).callonPrimary1227,
+												expr: &seqExpr{
+													pos: position{line: 38, col: 24, offset: 3372},
+													exprs: []any{
+														&zeroOrOneExpr{
+															pos: position{line: 38, col: 24, offset: 3372},
+															expr: &litMatcher{
+																pos:        position{line: 38, col: 24, offset: 3372},
+																val:        "-",
+																ignoreCase: false,
+																want:       "\"-\"",
+															},
+														},
+														&oneOrMoreExpr{
+															pos: position{line: 39, col: 24, offset: 3477},
+															expr: &seqExpr{
+																pos: position{line: 39, col: 26, offset: 3479},
+																exprs: []any{
+																	&oneOrMoreExpr{
+																		pos: position{line: 39, col: 26, offset: 3479},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 32, col: 24, offset: 2762},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&choiceExpr{
+																		pos: position{line: 40, col: 24, offset: 3532},
+																		alternatives: []any{
+																			&litMatcher{
+																				pos:        position{line: 40, col: 24, offset: 3532},
+																				val:        "ns",
+																				ignoreCase: false,
+																				want:       "\"ns\"",
+																			},
+																			&litMatcher{
+																				pos:        position{line: 40, col: 31, offset: 3539},
+																				val:        "us",
+																				ignoreCase: false,
+																				want:       "\"us\"",
+																			},
+																			&litMatcher{
+																				pos:        position{line: 40, col: 38, offset: 3546},
+																				val:        "ms",
+																				ignoreCase: false,
+																				want:       "\"ms\"",
+																			},
+																			&charClassMatcher{
+																				pos:        position{line: 40, col: 45, offset: 3553},
+																				val:        "[smhdw]",
+																				chars:      []rune{'s', 'm', 'h', 'd', 'w'},
+																				ignoreCase: false,
+																				inverted:   false,
+																			},
+																		},
+																	},
+																},
+															},
+														},
+														&notExpr{
+															pos: position{line: 27, col: 24, offset: 2310},
+															expr: &charClassMatcher{
+																pos:        position{line: 27, col: 25, offset: 2311},
+																val:        "[_a-zA-Z0-9]",
+																chars:      []rune{'_'},
+																ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+														&notExpr{
+															pos: position{line: 27, col: 38, offset: 2324},
+															expr: &seqExpr{
+																pos: position{line: 27, col: 41, offset: 2327},
+																exprs: []any{
+																	&litMatcher{
+																		pos:        position{line: 27, col: 41, offset: 2327},
+																		val:        ".",
+																		ignoreCase: false,
+																		want:       "\".\"",
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 27, col: 45, offset: 2331},
+																		val:        "[_a-zA-Z0-9]",
+																		chars:      []rune{'_'},
+																		ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 42, col: 24, offset: 3633},
+												run: (*parser).callonPrimary1246,
+												expr: &seqExpr{
+													pos: position{line: 42, col: 24, offset: 3633},
+													exprs: []any{
+														&andExpr{
+															pos: position{line: 42, col: 24, offset: 3633},
+															expr: &seqExpr{
+																pos: position{line: 42, col: 27, offset: 3636},
+																exprs: []any{
+																	&zeroOrMoreExpr{
+																		pos: position{line: 42, col: 27, offset: 3636},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 42, col: 27, offset: 3636},
+																			val:        "[_.-a-zA-Z0-9]",
+																			chars:      []rune{'_', '.', '-'},
+																			ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 42, col: 43, offset: 3652},
+																		val:        "[*?]",
+																		chars:      []rune{'*', '?'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
+														&oneOrMoreExpr{
+															pos: position{line: 42, col: 50, offset: 3659},
+															expr: &charClassMatcher{
+																pos:        position{line: 43, col: 24, offset: 3785},
+																val:        "[_.*?-a-zA-Z0-9]",
+																chars:      []rune{'_', '.', '*', '?', '-'},
+																ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 31, col: 24, offset: 2659},
+												run: (*parser).callonPrimary1255,
+												expr: &seqExpr{
+													pos: position{line: 31, col: 24, offset: 2659},
+													exprs: []any{
+														&zeroOrOneExpr{
+															pos: position{line: 31, col: 24, offset: 2659},
+															expr: &litMatcher{
+																pos:        position{line: 31, col: 24, offset: 2659},
+																val:        "-",
+																ignoreCase: false,
+																want:       "\"-\"",
+															},
+														},
+														&choiceExpr{
+															pos: position{line: 30, col: 24, offset: 2596},
+															alternatives: []any{
+																&litMatcher{
+																	pos:        position{line: 30, col: 24, offset: 2596},
+																	val:        "0",
+																	ignoreCase: false,
+																	want:       "\"0\"",
+																},
+																&seqExpr{
+																	pos: position{line: 30, col: 30, offset: 2602},
+																	exprs: []any{
+																		&charClassMatcher{
+																			pos:        position{line: 41, col: 24, offset: 3604},
+																			val:        "[1-9]",
+																			ranges:     []rune{'1', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																		&zeroOrMoreExpr{
+																			pos: position{line: 30, col: 50, offset: 2622},
+																			expr: &charClassMatcher{
+																				pos:        position{line: 32, col: 24, offset: 2762},
+																				val:        "[0-9]",
+																				ranges:     []rune{'0', '9'},
+																				ignoreCase: false,
+																				inverted:   false,
+																			},
+																		},
+																	},
+																},
+															},
+														},
+														&zeroOrOneExpr{
+															pos: position{line: 31, col: 37, offset: 2672},
+															expr: &seqExpr{
+																pos: position{line: 31, col: 39, offset: 2674},
+																exprs: []any{
+																	&litMatcher{
+																		pos:        position{line: 31, col: 39, offset: 2674},
+																		val:        ".",
+																		ignoreCase: false,
+																		want:       "\".\"",
+																	},
+																	&oneOrMoreExpr{
+																		pos: position{line: 31, col: 43, offset: 2678},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 32, col: 24, offset: 2762},
+																			val:        "[0-9]",
+																			ranges:     []rune{'0', '9'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 28, col: 24, offset: 2369},
+												run: (*parser).callonPrimary1270,
+												expr: &seqExpr{
+													pos: position{line: 28, col: 24, offset: 2369},
+													exprs: []any{
+														&choiceExpr{
+															pos: position{line: 28, col: 26, offset: 2371},
+															alternatives: []any{
+																&litMatcher{
+																	pos:        position{line: 28, col: 26, offset: 2371},
+																	val:        "true",
+																	ignoreCase: false,
+																	want:       "\"true\"",
+																},
+																&litMatcher{
+																	pos:        position{line: 28, col: 35, offset: 2380},
+																	val:        "TRUE",
+																	ignoreCase: false,
+																	want:       "\"TRUE\"",
+																},
+																&litMatcher{
+																	pos:        position{line: 28, col: 44, offset: 2389},
+																	val:        "false",
+																	ignoreCase: false,
+																	want:       "\"false\"",
+																},
+																&litMatcher{
+																	pos:        position{line: 28, col: 54, offset: 2399},
+																	val:        "FALSE",
+																	ignoreCase: false,
+																	want:       "\"FALSE\"",
+																},
+															},
+														},
+														&notExpr{
+															pos: position{line: 27, col: 24, offset: 2310},
 															expr: &charClassMatcher{
-																pos:        position{line: 33, col: 24, offset: 2101},
-																val:        "[ \\t\\r\\n]",
-																chars:      []rune{' ', '\t', '\r', '\n'},
+																pos:        position{line: 27, col: 25, offset: 2311},
+																val:        "[_a-zA-Z0-9]",
+																chars:      []rune{'_'},
+																ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+														&notExpr{
+															pos: position{line: 27, col: 38, offset: 2324},
+															expr: &seqExpr{
+																pos: position{line: 27, col: 41, offset: 2327},
+																exprs: []any{
+																	&litMatcher{
+																		pos:        position{line: 27, col: 41, offset: 2327},
+																		val:        ".",
+																		ignoreCase: false,
+																		want:       "\".\"",
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 27, col: 45, offset: 2331},
+																		val:        "[_a-zA-Z0-9]",
+																		chars:      []rune{'_'},
+																		ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 29, col: 24, offset: 2473},
+												run: (*parser).callonPrimary1283,
+												expr: &seqExpr{
+													pos: position{line: 29, col: 24, offset: 2473},
+													exprs: []any{
+														&choiceExpr{
+															pos: position{line: 29, col: 26, offset: 2475},
+															alternatives: []any{
+																&litMatcher{
+																	pos:        position{line: 29, col: 26, offset: 2475},
+																	val:        "null",
+																	ignoreCase: false,
+																	want:       "\"null\"",
+																},
+																&litMatcher{
+																	pos:        position{line: 29, col: 35, offset: 2484},
+																	val:        "NULL",
+																	ignoreCase: false,
+																	want:       "\"NULL\"",
+																},
+															},
+														},
+														&notExpr{
+															pos: position{line: 27, col: 24, offset: 2310},
+															expr: &charClassMatcher{
+																pos:        position{line: 27, col: 25, offset: 2311},
+																val:        "[_a-zA-Z0-9]",
+																chars:      []rune{'_'},
+																ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+														&notExpr{
+															pos: position{line: 27, col: 38, offset: 2324},
+															expr: &seqExpr{
+																pos: position{line: 27, col: 41, offset: 2327},
+																exprs: []any{
+																	&litMatcher{
+																		pos:        position{line: 27, col: 41, offset: 2327},
+																		val:        ".",
+																		ignoreCase: false,
+																		want:       "\".\"",
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 27, col: 45, offset: 2331},
+																		val:        "[_a-zA-Z0-9]",
+																		chars:      []rune{'_'},
+																		ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+											&actionExpr{
+												pos: position{line: 24, col: 24, offset: 1998},
+												run: (*parser).callonPrimary1294,
+												expr: &actionExpr{
+													pos: position{line: 25, col: 24, offset: 2152},
+													run: (*parser).callonPrimary1295,
+													expr: &seqExpr{
+														pos: position{line: 25, col: 24, offset: 2152},
+														exprs: []any{
+															&charClassMatcher{
+																pos:        position{line: 26, col: 24, offset: 2264},
+																val:        "[_a-zA-Z]",
+																chars:      []rune{'_'},
+																ranges:     []rune{'a', 'z', 'A', 'Z'},
 																ignoreCase: false,
 																inverted:   false,
 															},
+															&zeroOrMoreExpr{
+																pos: position{line: 26, col: 33, offset: 2273},
+																expr: &charClassMatcher{
+																	pos:        position{line: 26, col: 33, offset: 2273},
+																	val:        "[_a-zA-Z0-9]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+															&zeroOrMoreExpr{
+																pos: position{line: 25, col: 37, offset: 2165},
+																expr: &seqExpr{
+																	pos: position{line: 25, col: 38, offset: 2166},
+																	exprs: []any{
+																		&litMatcher{
+																			pos:        position{line: 25, col: 38, offset: 2166},
+																			val:        ".",
+																			ignoreCase: false,
+																			want:       "\".\"",
+																		},
+																		&charClassMatcher{
+																			pos:        position{line: 26, col: 24, offset: 2264},
+																			val:        "[_a-zA-Z]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'a', 'z', 'A', 'Z'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																		&zeroOrMoreExpr{
+																			pos: position{line: 26, col: 33, offset: 2273},
+																			expr: &charClassMatcher{
+																				pos:        position{line: 26, col: 33, offset: 2273},
+																				val:        "[_a-zA-Z0-9]",
+																				chars:      []rune{'_'},
+																				ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+																				ignoreCase: false,
+																				inverted:   false,
+																			},
+																		},
+																	},
+																},
+															},
 														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 18, col: 24, offset: 1378},
+						run: (*parser).callonPrimary1306,
+						expr: &seqExpr{
+							pos: position{line: 18, col: 24, offset: 1378},
+							exprs: []any{
+								&notExpr{
+									pos: position{line: 18, col: 24, offset: 1378},
+									expr: &seqExpr{
+										pos: position{line: 20, col: 24, offset: 1650},
+										exprs: []any{
+											&choiceExpr{
+												pos: position{line: 20, col: 26, offset: 1652},
+												alternatives: []any{
+													&litMatcher{
+														pos:        position{line: 20, col: 26, offset: 1652},
+														val:        "AND",
+														ignoreCase: false,
+														want:       "\"AND\"",
+													},
+													&litMatcher{
+														pos:        position{line: 20, col: 34, offset: 1660},
+														val:        "and",
+														ignoreCase: false,
+														want:       "\"and\"",
+													},
+													&litMatcher{
+														pos:        position{line: 20, col: 42, offset: 1668},
+														val:        "OR",
+														ignoreCase: false,
+														want:       "\"OR\"",
+													},
+													&litMatcher{
+														pos:        position{line: 20, col: 49, offset: 1675},
+														val:        "or",
+														ignoreCase: false,
+														want:       "\"or\"",
+													},
+													&litMatcher{
+														pos:        position{line: 20, col: 56, offset: 1682},
+														val:        "NOT",
+														ignoreCase: false,
+														want:       "\"NOT\"",
+													},
+													&litMatcher{
+														pos:        position{line: 20, col: 64, offset: 1690},
+														val:        "not",
+														ignoreCase: false,
+														want:       "\"not\"",
+													},
+												},
+											},
+											&notExpr{
+												pos: position{line: 27, col: 24, offset: 2310},
+												expr: &charClassMatcher{
+													pos:        position{line: 27, col: 25, offset: 2311},
+													val:        "[_a-zA-Z0-9]",
+													chars:      []rune{'_'},
+													ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+													ignoreCase: false,
+													inverted:   false,
+												},
+											},
+											&notExpr{
+												pos: position{line: 27, col: 38, offset: 2324},
+												expr: &seqExpr{
+													pos: position{line: 27, col: 41, offset: 2327},
+													exprs: []any{
 														&litMatcher{
-															pos:        position{line: 31, col: 54, offset: 1897},
-															val:        "]",
+															pos:        position{line: 27, col: 41, offset: 2327},
+															val:        ".",
 															ignoreCase: false,
-															want:       "\"]\"",
+															want:       "\".\"",
+														},
+														&charClassMatcher{
+															pos:        position{line: 27, col: 45, offset: 2331},
+															val:        "[_a-zA-Z0-9]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+															ignoreCase: false,
+															inverted:   false,
 														},
 													},
 												},
 											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 18, col: 33, offset: 1387},
+									label: "value",
+									expr: &choiceExpr{
+										pos: position{line: 18, col: 41, offset: 1395},
+										alternatives: []any{
 											&actionExpr{
-												pos: position{line: 23, col: 24, offset: 1391},
-												run: (*parser).callonPrimary147,
+												pos: position{line: 45, col: 24, offset: 3927},
+												run: (*parser).callonPrimary1325,
 												expr: &seqExpr{
-													pos: position{line: 23, col: 24, offset: 1391},
+													pos: position{line: 45, col: 24, offset: 3927},
 													exprs: []any{
 														&litMatcher{
-															pos:        position{line: 23, col: 24, offset: 1391},
+															pos:        position{line: 45, col: 24, offset: 3927},
 															val:        "\"",
 															ignoreCase: false,
 															want:       "\"\\\"\"",
 														},
 														&zeroOrMoreExpr{
-															pos: position{line: 24, col: 24, offset: 1494},
+															pos: position{line: 46, col: 24, offset: 4030},
 															expr: &choiceExpr{
-																pos: position{line: 24, col: 26, offset: 1496},
+																pos: position{line: 46, col: 26, offset: 4032},
 																alternatives: []any{
 																	&seqExpr{
-																		pos: position{line: 24, col: 26, offset: 1496},
+																		pos: position{line: 46, col: 26, offset: 4032},
 																		exprs: []any{
 																			&notExpr{
-																				pos: position{line: 24, col: 26, offset: 1496},
+																				pos: position{line: 46, col: 26, offset: 4032},
 																				expr: &charClassMatcher{
-																					pos:        position{line: 25, col: 24, offset: 1559},
+																					pos:        position{line: 47, col: 24, offset: 4095},
 																					val:        "[\"\\\\\\x00-\\x1f]",
 																					chars:      []rune{'"', '\\'},
 																					ranges:     []rune{'\x00', '\x1f'},
@@ -1060,177 +7691,94 @@ var g = &grammar{
 																				},
 																			},
 																			&anyMatcher{
-																				line: 24, col: 39, offset: 1509,
+																				line: 46, col: 39, offset: 4045,
 																			},
 																		},
 																	},
 																	&seqExpr{
-																		pos: position{line: 24, col: 43, offset: 1513},
+																		pos: position{line: 46, col: 43, offset: 4049},
 																		exprs: []any{
 																			&litMatcher{
-																				pos:        position{line: 24, col: 43, offset: 1513},
+																				pos:        position{line: 46, col: 43, offset: 4049},
 																				val:        "\\",
 																				ignoreCase: false,
 																				want:       "\"\\\\\"",
 																			},
 																			&choiceExpr{
-																				pos: position{line: 26, col: 24, offset: 1597},
+																				pos: position{line: 48, col: 24, offset: 4133},
 																				alternatives: []any{
 																					&charClassMatcher{
-																						pos:        position{line: 27, col: 24, offset: 1653},
+																						pos:        position{line: 49, col: 24, offset: 4189},
 																						val:        "[\"\\\\/bfnrt]",
 																						chars:      []rune{'"', '\\', '/', 'b', 'f', 'n', 'r', 't'},
 																						ignoreCase: false,
 																						inverted:   false,
 																					},
 																					&seqExpr{
-																						pos: position{line: 28, col: 24, offset: 1688},
+																						pos: position{line: 50, col: 24, offset: 4224},
 																						exprs: []any{
 																							&litMatcher{
-																								pos:        position{line: 28, col: 24, offset: 1688},
+																								pos:        position{line: 50, col: 24, offset: 4224},
 																								val:        "u",
 																								ignoreCase: false,
 																								want:       "\"u\"",
 																							},
 																							&charClassMatcher{
-																								pos:        position{line: 29, col: 24, offset: 1751},
+																								pos:        position{line: 51, col: 24, offset: 4287},
 																								val:        "[0-9a-f]i",
 																								ranges:     []rune{'0', '9', 'a', 'f'},
 																								ignoreCase: true,
 																								inverted:   false,
 																							},
 																							&charClassMatcher{
-																								pos:        position{line: 29, col: 24, offset: 1751},
+																								pos:        position{line: 51, col: 24, offset: 4287},
 																								val:        "[0-9a-f]i",
-																								ranges:     []rune{'0', '9', 'a', 'f'},
-																								ignoreCase: true,
-																								inverted:   false,
-																							},
-																							&charClassMatcher{
-																								pos:        position{line: 29, col: 24, offset: 1751},
-																								val:        "[0-9a-f]i",
-																								ranges:     []rune{'0', '9', 'a', 'f'},
-																								ignoreCase: true,
-																								inverted:   false,
-																							},
-																							&charClassMatcher{
-																								pos:        position{line: 29, col: 24, offset: 1751},
-																								val:        "[0-9a-f]i",
-																								ranges:     []rune{'0', '9', 'a', 'f'},
-																								ignoreCase: true,
-																								inverted:   false,
-																							},
-																						},
-																					},
-																				},
-																			},
-																		},
-																	},
-																},
-															},
-														},
-														&litMatcher{
-															pos:        position{line: 23, col: 40, offset: 1407},
-															val:        "\"",
-															ignoreCase: false,
-															want:       "\"\\\"\"",
-														},
-													},
-												},
-											},
-											&actionExpr{
-												pos: position{line: 20, col: 24, offset: 1230},
-												run: (*parser).callonPrimary167,
-												expr: &seqExpr{
-													pos: position{line: 20, col: 24, offset: 1230},
-													exprs: []any{
-														&zeroOrOneExpr{
-															pos: position{line: 20, col: 24, offset: 1230},
-															expr: &litMatcher{
-																pos:        position{line: 20, col: 24, offset: 1230},
-																val:        "-",
-																ignoreCase: false,
-																want:       "\"-\"",
-															},
-														},
-														&choiceExpr{
-															pos: position{line: 19, col: 24, offset: 1167},
-															alternatives: []any{
-																&litMatcher{
-																	pos:        position{line: 19, col: 24, offset: 1167},
-																	val:        "0",
-																	ignoreCase: false,
-																	want:       "\"0\"",
-																},
-																&seqExpr{
-																	pos: position{line: 19, col: 30, offset: 1173},
-																	exprs: []any{
-																		&charClassMatcher{
-																			pos:        position{line: 22, col: 24, offset: 1362},
-																			val:        "[1-9]",
-																			ranges:     []rune{'1', '9'},
-																			ignoreCase: false,
-																			inverted:   false,
-																		},
-																		&zeroOrMoreExpr{
-																			pos: position{line: 19, col: 50, offset: 1193},
-																			expr: &charClassMatcher{
-																				pos:        position{line: 21, col: 24, offset: 1333},
-																				val:        "[0-9]",
-																				ranges:     []rune{'0', '9'},
-																				ignoreCase: false,
-																				inverted:   false,
+																								ranges:     []rune{'0', '9', 'a', 'f'},
+																								ignoreCase: true,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 51, col: 24, offset: 4287},
+																								val:        "[0-9a-f]i",
+																								ranges:     []rune{'0', '9', 'a', 'f'},
+																								ignoreCase: true,
+																								inverted:   false,
+																							},
+																							&charClassMatcher{
+																								pos:        position{line: 51, col: 24, offset: 4287},
+																								val:        "[0-9a-f]i",
+																								ranges:     []rune{'0', '9', 'a', 'f'},
+																								ignoreCase: true,
+																								inverted:   false,
+																							},
+																						},
+																					},
+																				},
 																			},
 																		},
 																	},
 																},
 															},
 														},
-														&zeroOrOneExpr{
-															pos: position{line: 20, col: 37, offset: 1243},
-															expr: &seqExpr{
-																pos: position{line: 20, col: 39, offset: 1245},
-																exprs: []any{
-																	&litMatcher{
-																		pos:        position{line: 20, col: 39, offset: 1245},
-																		val:        ".",
-																		ignoreCase: false,
-																		want:       "\".\"",
-																	},
-																	&oneOrMoreExpr{
-																		pos: position{line: 20, col: 43, offset: 1249},
-																		expr: &charClassMatcher{
-																			pos:        position{line: 21, col: 24, offset: 1333},
-																			val:        "[0-9]",
-																			ranges:     []rune{'0', '9'},
-																			ignoreCase: false,
-																			inverted:   false,
-																		},
-																	},
-																},
-															},
+														&litMatcher{
+															pos:        position{line: 45, col: 40, offset: 3943},
+															val:        "\"",
+															ignoreCase: false,
+															want:       "\"\\\"\"",
 														},
 													},
 												},
 											},
 											&actionExpr{
-												pos: position{line: 17, col: 24, offset: 1009},
-												run: (*parser).callonPrimary182,
+												pos: position{line: 19, col: 24, offset: 1496},
+												run: (*parser).callonPrimary1345,
 												expr: &seqExpr{
-													pos: position{line: 17, col: 24, offset: 1009},
+													pos: position{line: 19, col: 24, offset: 1496},
 													exprs: []any{
-														&charClassMatcher{
-															pos:        position{line: 18, col: 24, offset: 1121},
-															val:        "[_a-zA-Z]",
-															chars:      []rune{'_'},
-															ranges:     []rune{'a', 'z', 'A', 'Z'},
-															ignoreCase: false,
-															inverted:   false,
-														},
-														&zeroOrMoreExpr{
-															pos: position{line: 18, col: 33, offset: 1130},
+														&oneOrMoreExpr{
+															pos: position{line: 19, col: 24, offset: 1496},
 															expr: &charClassMatcher{
-																pos:        position{line: 18, col: 33, offset: 1130},
+																pos:        position{line: 19, col: 24, offset: 1496},
 																val:        "[_a-zA-Z0-9]",
 																chars:      []rune{'_'},
 																ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -1239,28 +7787,21 @@ var g = &grammar{
 															},
 														},
 														&zeroOrMoreExpr{
-															pos: position{line: 17, col: 37, offset: 1022},
+															pos: position{line: 19, col: 38, offset: 1510},
 															expr: &seqExpr{
-																pos: position{line: 17, col: 38, offset: 1023},
+																pos: position{line: 19, col: 40, offset: 1512},
 																exprs: []any{
-																	&litMatcher{
-																		pos:        position{line: 17, col: 38, offset: 1023},
-																		val:        ".",
-																		ignoreCase: false,
-																		want:       "\".\"",
-																	},
 																	&charClassMatcher{
-																		pos:        position{line: 18, col: 24, offset: 1121},
-																		val:        "[_a-zA-Z]",
-																		chars:      []rune{'_'},
-																		ranges:     []rune{'a', 'z', 'A', 'Z'},
+																		pos:        position{line: 19, col: 40, offset: 1512},
+																		val:        "[.-]",
+																		chars:      []rune{'.', '-'},
 																		ignoreCase: false,
 																		inverted:   false,
 																	},
-																	&zeroOrMoreExpr{
-																		pos: position{line: 18, col: 33, offset: 1130},
+																	&oneOrMoreExpr{
+																		pos: position{line: 19, col: 45, offset: 1517},
 																		expr: &charClassMatcher{
-																			pos:        position{line: 18, col: 33, offset: 1130},
+																			pos:        position{line: 19, col: 45, offset: 1517},
 																			val:        "[_a-zA-Z0-9]",
 																			chars:      []rune{'_'},
 																			ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -1285,23 +7826,23 @@ var g = &grammar{
 		},
 		{
 			name: "ParenExpr",
-			pos:  position{line: 13, col: 1, offset: 638},
+			pos:  position{line: 14, col: 1, offset: 862},
 			expr: &actionExpr{
-				pos: position{line: 13, col: 24, offset: 661},
+				pos: position{line: 14, col: 24, offset: 885},
 				run: (*parser).callonParenExpr1,
 				expr: &seqExpr{
-					pos: position{line: 13, col: 24, offset: 661},
+					pos: position{line: 14, col: 24, offset: 885},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 13, col: 24, offset: 661},
+							pos:        position{line: 14, col: 24, offset: 885},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 33, col: 24, offset: 2101},
+							pos: position{line: 65, col: 24, offset: 5441},
 							expr: &charClassMatcher{
-								pos:        position{line: 33, col: 24, offset: 2101},
+								pos:        position{line: 65, col: 24, offset: 5441},
 								val:        "[ \\t\\r\\n]",
 								chars:      []rune{' ', '\t', '\r', '\n'},
 								ignoreCase: false,
@@ -1309,17 +7850,17 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 13, col: 30, offset: 667},
+							pos:   position{line: 14, col: 30, offset: 891},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 13, col: 35, offset: 672},
+								pos:  position{line: 14, col: 35, offset: 896},
 								name: "Expr",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 33, col: 24, offset: 2101},
+							pos: position{line: 65, col: 24, offset: 5441},
 							expr: &charClassMatcher{
-								pos:        position{line: 33, col: 24, offset: 2101},
+								pos:        position{line: 65, col: 24, offset: 5441},
 								val:        "[ \\t\\r\\n]",
 								chars:      []rune{' ', '\t', '\r', '\n'},
 								ignoreCase: false,
@@ -1327,7 +7868,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 13, col: 42, offset: 679},
+							pos:        position{line: 14, col: 42, offset: 903},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -1339,178 +7880,848 @@ var g = &grammar{
 	},
 }
 
-func (c *current) onExpr1(e any) (any, error) {
-	return e, nil
+func (c *current) onQuery1(e any) (any, error) {
+	return e, nil
+}
+
+func (p *parser) callonQuery1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuery1(stack["e"])
+}
+
+func (c *current) onExpr1(e any) (any, error) {
+	return e, nil
+}
+
+func (p *parser) callonExpr1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onExpr1(stack["e"])
+}
+
+func (c *current) onOrExpr10() (any, error) {
+	return c.text, nil
+}
+
+func (p *parser) callonOrExpr10() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOrExpr10()
+}
+
+func (c *current) onOrExpr1(left, rest any) (any, error) {
+	return parseBooleanExpression(left, rest)
+}
+
+func (p *parser) callonOrExpr1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOrExpr1(stack["left"], stack["rest"])
+}
+
+func (c *current) onAndExpr11() (any, error) {
+	return c.text, nil
+}
+
+func (p *parser) callonAndExpr11() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAndExpr11()
+}
+
+func (c *current) onAndExpr1(left, rest any) (any, error) {
+	return parseBooleanExpression(left, rest)
+}
+
+func (p *parser) callonAndExpr1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAndExpr1(stack["left"], stack["rest"])
+}
+
+func (c *current) onNotExpr2(expr any) (any, error) {
+	return parseNotExpression(c, expr)
+}
+
+func (p *parser) callonNotExpr2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNotExpr2(stack["expr"])
+}
+
+func (c *current) onPrimary6() (any, error) {
+	return Identifier(c.text), nil
+}
+
+func (p *parser) callonPrimary6() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary6()
+}
+
+func (c *current) onPrimary3(field any) (any, error) {
+	return parseExistsExpression(c, field)
+}
+
+func (p *parser) callonPrimary3() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary3(stack["field"])
+}
+
+func (c *current) onPrimary28() (any, error) {
+	return Identifier(c.text), nil
+}
+
+func (p *parser) callonPrimary28() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary28()
+}
+
+func (c *current) onPrimary42() (any, error) {
+	return c.text, nil
+}
+
+func (p *parser) callonPrimary42() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary42()
+}
+
+func (c *current) onPrimary69() (any, error) {
+	return parseString(c)
+}
+
+func (p *parser) callonPrimary69() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary69()
+}
+
+func (c *current) onPrimary89() (any, error) {
+	return parseDateTime(c)
+}
+
+func (p *parser) callonPrimary89() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary89()
+}
+
+func (c *current) onPrimary132() (any, error) {
+	return parseRelativeTime(c)
+}
+
+func (p *parser) callonPrimary132() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary132()
+}
+
+func (c *current) onPrimary153() (any, error) {
+	return parseDuration(c)
+}
+
+func (p *parser) callonPrimary153() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary153()
+}
+
+func (c *current) onPrimary172() (any, error) {
+	return parseNumber(c)
+}
+
+func (p *parser) callonPrimary172() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary172()
+}
+
+func (c *current) onPrimary187() (any, error) {
+	return parseBoolean(c)
+}
+
+func (p *parser) callonPrimary187() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary187()
+}
+
+func (c *current) onPrimary201() (any, error) {
+	return Identifier(c.text), nil
+}
+
+func (p *parser) callonPrimary201() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary201()
+}
+
+func (c *current) onPrimary200() (any, error) {
+	return &StringLiteral{StringValue: string(c.text), Span: spanOf(c)}, nil
+}
+
+func (p *parser) callonPrimary200() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary200()
+}
+
+func (c *current) onPrimary221() (any, error) {
+	return parseString(c)
+}
+
+func (p *parser) callonPrimary221() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary221()
+}
+
+func (c *current) onPrimary241() (any, error) {
+	return parseDateTime(c)
+}
+
+func (p *parser) callonPrimary241() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary241()
+}
+
+func (c *current) onPrimary284() (any, error) {
+	return parseRelativeTime(c)
+}
+
+func (p *parser) callonPrimary284() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary284()
+}
+
+func (c *current) onPrimary305() (any, error) {
+	return parseDuration(c)
+}
+
+func (p *parser) callonPrimary305() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary305()
+}
+
+func (c *current) onPrimary324() (any, error) {
+	return parseNumber(c)
+}
+
+func (p *parser) callonPrimary324() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary324()
+}
+
+func (c *current) onPrimary339() (any, error) {
+	return parseBoolean(c)
+}
+
+func (p *parser) callonPrimary339() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary339()
+}
+
+func (c *current) onPrimary353() (any, error) {
+	return Identifier(c.text), nil
+}
+
+func (p *parser) callonPrimary353() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary353()
+}
+
+func (c *current) onPrimary352() (any, error) {
+	return &StringLiteral{StringValue: string(c.text), Span: spanOf(c)}, nil
+}
+
+func (p *parser) callonPrimary352() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary352()
+}
+
+func (c *current) onPrimary65(head, tail any) (any, error) {
+	return parseOneOfValues(head, tail)
+}
+
+func (p *parser) callonPrimary65() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary65(stack["head"], stack["tail"])
+}
+
+func (c *current) onPrimary58(values any) (any, error) {
+	return parseOneOfExpression(c, values)
+}
+
+func (p *parser) callonPrimary58() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary58(stack["values"])
+}
+
+func (c *current) onPrimary25(field, op, value any) (any, error) {
+	return parseFieldExpression(c, field, op, value)
 }
 
-func (p *parser) callonExpr1() (any, error) {
+func (p *parser) callonPrimary25() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExpr1(stack["e"])
+	return p.cur.onPrimary25(stack["field"], stack["op"], stack["value"])
 }
 
-func (c *current) onOrExpr1(left, rest any) (any, error) {
-	return parseBooleanExpression(left, rest)
+func (c *current) onPrimary370() (any, error) {
+	return Identifier(c.text), nil
 }
 
-func (p *parser) callonOrExpr1() (any, error) {
+func (p *parser) callonPrimary370() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOrExpr1(stack["left"], stack["rest"])
+	return p.cur.onPrimary370()
 }
 
-func (c *current) onAndExpr1(left, rest any) (any, error) {
-	return parseBooleanExpression(left, rest)
+func (c *current) onPrimary406() (any, error) {
+	return parseDateTime(c)
 }
 
-func (p *parser) callonAndExpr1() (any, error) {
+func (p *parser) callonPrimary406() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAndExpr1(stack["left"], stack["rest"])
+	return p.cur.onPrimary406()
 }
 
-func (c *current) onNotExpr2(expr any) (any, error) {
-	return &NotExpr{Expr: expr.(Expr)}, nil
+func (c *current) onPrimary449() (any, error) {
+	return parseRelativeTime(c)
 }
 
-func (p *parser) callonNotExpr2() (any, error) {
+func (p *parser) callonPrimary449() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNotExpr2(stack["expr"])
+	return p.cur.onPrimary449()
 }
 
-func (c *current) onPrimary6() (any, error) {
-	return Identifier(c.text), nil
+func (c *current) onPrimary470() (any, error) {
+	return parseDuration(c)
 }
 
-func (p *parser) callonPrimary6() (any, error) {
+func (p *parser) callonPrimary470() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary6()
+	return p.cur.onPrimary470()
+}
+
+func (c *current) onPrimary489() (any, error) {
+	return parseNumber(c)
+}
+
+func (p *parser) callonPrimary489() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary489()
+}
+
+func (c *current) onPrimary504() (any, error) {
+	return nil, nil
+}
+
+func (p *parser) callonPrimary504() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary504()
+}
+
+func (c *current) onPrimary515() (any, error) {
+	return parseDateTime(c)
+}
+
+func (p *parser) callonPrimary515() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary515()
+}
+
+func (c *current) onPrimary558() (any, error) {
+	return parseRelativeTime(c)
+}
+
+func (p *parser) callonPrimary558() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary558()
+}
+
+func (c *current) onPrimary579() (any, error) {
+	return parseDuration(c)
+}
+
+func (p *parser) callonPrimary579() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary579()
+}
+
+func (c *current) onPrimary598() (any, error) {
+	return parseNumber(c)
+}
+
+func (p *parser) callonPrimary598() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary598()
+}
+
+func (c *current) onPrimary613() (any, error) {
+	return nil, nil
+}
+
+func (p *parser) callonPrimary613() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary613()
+}
+
+func (c *current) onPrimary398(left, low, high, right any) (any, error) {
+	return parseRangeExpression(c, left, low, high, right)
+}
+
+func (p *parser) callonPrimary398() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary398(stack["left"], stack["low"], stack["high"], stack["right"])
+}
+
+func (c *current) onPrimary623() (any, error) {
+	return parseDateTime(c)
+}
+
+func (p *parser) callonPrimary623() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary623()
+}
+
+func (c *current) onPrimary666() (any, error) {
+	return parseRelativeTime(c)
+}
+
+func (p *parser) callonPrimary666() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary666()
+}
+
+func (c *current) onPrimary687() (any, error) {
+	return parseDuration(c)
+}
+
+func (p *parser) callonPrimary687() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary687()
+}
+
+func (c *current) onPrimary706() (any, error) {
+	return parseNumber(c)
+}
+
+func (p *parser) callonPrimary706() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary706()
+}
+
+func (c *current) onPrimary724() (any, error) {
+	return parseDateTime(c)
+}
+
+func (p *parser) callonPrimary724() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary724()
+}
+
+func (c *current) onPrimary767() (any, error) {
+	return parseRelativeTime(c)
+}
+
+func (p *parser) callonPrimary767() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary767()
+}
+
+func (c *current) onPrimary788() (any, error) {
+	return parseDuration(c)
+}
+
+func (p *parser) callonPrimary788() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary788()
+}
+
+func (c *current) onPrimary807() (any, error) {
+	return parseNumber(c)
+}
+
+func (p *parser) callonPrimary807() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary807()
+}
+
+func (c *current) onPrimary619(low, high any) (any, error) {
+	return parseRangeExpression(c, nil, low, high, nil)
+}
+
+func (p *parser) callonPrimary619() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary619(stack["low"], stack["high"])
 }
 
-func (c *current) onPrimary43() (any, error) {
+func (c *current) onPrimary833() (any, error) {
 	return parseString(c)
 }
 
-func (p *parser) callonPrimary43() (any, error) {
+func (p *parser) callonPrimary833() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary43()
+	return p.cur.onPrimary833()
 }
 
-func (c *current) onPrimary63() (any, error) {
+func (c *current) onPrimary853() (any, error) {
+	return parseDateTime(c)
+}
+
+func (p *parser) callonPrimary853() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary853()
+}
+
+func (c *current) onPrimary896() (any, error) {
+	return parseRelativeTime(c)
+}
+
+func (p *parser) callonPrimary896() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary896()
+}
+
+func (c *current) onPrimary917() (any, error) {
+	return parseDuration(c)
+}
+
+func (p *parser) callonPrimary917() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary917()
+}
+
+func (c *current) onPrimary936() (any, error) {
 	return parseNumber(c)
 }
 
-func (p *parser) callonPrimary63() (any, error) {
+func (p *parser) callonPrimary936() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary936()
+}
+
+func (c *current) onPrimary951() (any, error) {
+	return parseBoolean(c)
+}
+
+func (p *parser) callonPrimary951() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary63()
+	return p.cur.onPrimary951()
 }
 
-func (c *current) onPrimary78() (any, error) {
+func (c *current) onPrimary965() (any, error) {
 	return Identifier(c.text), nil
 }
 
-func (p *parser) callonPrimary78() (any, error) {
+func (p *parser) callonPrimary965() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary78()
+	return p.cur.onPrimary965()
 }
 
-func (c *current) onPrimary98() (any, error) {
+func (c *current) onPrimary964() (any, error) {
+	return &StringLiteral{StringValue: string(c.text), Span: spanOf(c)}, nil
+}
+
+func (p *parser) callonPrimary964() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary964()
+}
+
+func (c *current) onPrimary985() (any, error) {
 	return parseString(c)
 }
 
-func (p *parser) callonPrimary98() (any, error) {
+func (p *parser) callonPrimary985() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary985()
+}
+
+func (c *current) onPrimary1005() (any, error) {
+	return parseDateTime(c)
+}
+
+func (p *parser) callonPrimary1005() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary1005()
+}
+
+func (c *current) onPrimary1048() (any, error) {
+	return parseRelativeTime(c)
+}
+
+func (p *parser) callonPrimary1048() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary1048()
+}
+
+func (c *current) onPrimary1069() (any, error) {
+	return parseDuration(c)
+}
+
+func (p *parser) callonPrimary1069() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary98()
+	return p.cur.onPrimary1069()
 }
 
-func (c *current) onPrimary118() (any, error) {
+func (c *current) onPrimary1088() (any, error) {
 	return parseNumber(c)
 }
 
-func (p *parser) callonPrimary118() (any, error) {
+func (p *parser) callonPrimary1088() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary118()
+	return p.cur.onPrimary1088()
 }
 
-func (c *current) onPrimary133() (any, error) {
+func (c *current) onPrimary1103() (any, error) {
+	return parseBoolean(c)
+}
+
+func (p *parser) callonPrimary1103() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary1103()
+}
+
+func (c *current) onPrimary1117() (any, error) {
 	return Identifier(c.text), nil
 }
 
-func (p *parser) callonPrimary133() (any, error) {
+func (p *parser) callonPrimary1117() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary1117()
+}
+
+func (c *current) onPrimary1116() (any, error) {
+	return &StringLiteral{StringValue: string(c.text), Span: spanOf(c)}, nil
+}
+
+func (p *parser) callonPrimary1116() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary133()
+	return p.cur.onPrimary1116()
 }
 
-func (c *current) onPrimary39(head, tail any) (any, error) {
+func (c *current) onPrimary829(head, tail any) (any, error) {
 	return parseOneOfValues(head, tail)
 }
 
-func (p *parser) callonPrimary39() (any, error) {
+func (p *parser) callonPrimary829() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary39(stack["head"], stack["tail"])
+	return p.cur.onPrimary829(stack["head"], stack["tail"])
 }
 
-func (c *current) onPrimary32(values any) (any, error) {
-	return parseOneOfExpression(values)
+func (c *current) onPrimary822(values any) (any, error) {
+	return parseOneOfExpression(c, values)
 }
 
-func (p *parser) callonPrimary32() (any, error) {
+func (p *parser) callonPrimary822() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary32(stack["values"])
+	return p.cur.onPrimary822(stack["values"])
 }
 
-func (c *current) onPrimary147() (any, error) {
+func (c *current) onPrimary1131() (any, error) {
 	return parseString(c)
 }
 
-func (p *parser) callonPrimary147() (any, error) {
+func (p *parser) callonPrimary1131() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary1131()
+}
+
+func (c *current) onPrimary1151() (any, error) {
+	return parseRegex(c)
+}
+
+func (p *parser) callonPrimary1151() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary1151()
+}
+
+func (c *current) onPrimary1163() (any, error) {
+	return parseDateTime(c)
+}
+
+func (p *parser) callonPrimary1163() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary1163()
+}
+
+func (c *current) onPrimary1206() (any, error) {
+	return parseRelativeTime(c)
+}
+
+func (p *parser) callonPrimary1206() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary1206()
+}
+
+func (c *current) onPrimary1227() (any, error) {
+	return parseDuration(c)
+}
+
+func (p *parser) callonPrimary1227() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary1227()
+}
+
+func (c *current) onPrimary1246() (any, error) {
+	return &WildcardLiteral{Pattern: string(c.text), Span: spanOf(c)}, nil
+}
+
+func (p *parser) callonPrimary1246() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary147()
+	return p.cur.onPrimary1246()
 }
 
-func (c *current) onPrimary167() (any, error) {
+func (c *current) onPrimary1255() (any, error) {
 	return parseNumber(c)
 }
 
-func (p *parser) callonPrimary167() (any, error) {
+func (p *parser) callonPrimary1255() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary1255()
+}
+
+func (c *current) onPrimary1270() (any, error) {
+	return parseBoolean(c)
+}
+
+func (p *parser) callonPrimary1270() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary167()
+	return p.cur.onPrimary1270()
 }
 
-func (c *current) onPrimary182() (any, error) {
+func (c *current) onPrimary1283() (any, error) {
+	return &NullLiteral{Span: spanOf(c)}, nil
+}
+
+func (p *parser) callonPrimary1283() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary1283()
+}
+
+func (c *current) onPrimary1295() (any, error) {
 	return Identifier(c.text), nil
 }
 
-func (p *parser) callonPrimary182() (any, error) {
+func (p *parser) callonPrimary1295() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary182()
+	return p.cur.onPrimary1295()
 }
 
-func (c *current) onPrimary3(field, op, value any) (any, error) {
-	return parseFieldExpression(field, op, value)
+func (c *current) onPrimary1294() (any, error) {
+	return &StringLiteral{StringValue: string(c.text), Span: spanOf(c)}, nil
 }
 
-func (p *parser) callonPrimary3() (any, error) {
+func (p *parser) callonPrimary1294() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary1294()
+}
+
+func (c *current) onPrimary367(field, op, value any) (any, error) {
+	return parseComparison(c, field, op, value)
+}
+
+func (p *parser) callonPrimary367() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary367(stack["field"], stack["op"], stack["value"])
+}
+
+func (c *current) onPrimary1325() (any, error) {
+	return parseString(c)
+}
+
+func (p *parser) callonPrimary1325() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary1325()
+}
+
+func (c *current) onPrimary1345() (any, error) {
+	return &StringLiteral{StringValue: string(c.text), Span: spanOf(c)}, nil
+}
+
+func (p *parser) callonPrimary1345() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPrimary1345()
+}
+
+func (c *current) onPrimary1306(value any) (any, error) {
+	return parseTermExpression(c, value)
+}
+
+func (p *parser) callonPrimary1306() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary3(stack["field"], stack["op"], stack["value"])
+	return p.cur.onPrimary1306(stack["value"])
 }
 
 func (c *current) onParenExpr1(expr any) (any, error) {
-	return expr.(Expr), nil
+	return parseParenExpression(c, expr)
 }
 
 func (p *parser) callonParenExpr1() (any, error) {
@@ -1,10 +1,49 @@
 package query
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"strconv"
+	"strings"
+	"time"
 )
 
+const (
+	clockKey           = "clock"
+	rawLikePatternsKey = "rawLikePatterns"
+)
+
+// durationUnits lists supported duration units from the largest to the smallest.
+var durationUnits = []struct {
+	name string
+	unit time.Duration
+}{
+	{"w", 7 * 24 * time.Hour},
+	{"d", 24 * time.Hour},
+	{"h", time.Hour},
+	{"m", time.Minute},
+	{"s", time.Second},
+	{"ms", time.Millisecond},
+	{"us", time.Microsecond},
+	{"ns", time.Nanosecond},
+}
+
+// Clock creates an Option to set the function used to resolve relative time literals like now-7d.
+//
+// The default is time.Now.
+func Clock(now func() time.Time) Option {
+	return GlobalStore(clockKey, now)
+}
+
+// RawLikePatterns creates an Option to treat string values of `~` and `~*` operators as raw LIKE patterns, e.g.
+// name ~ "J_hn%". Both SQL and struct matching then evaluate the pattern with LIKE semantics.
+//
+// By default `~` means “contains” and LIKE special characters in the value are escaped.
+func RawLikePatterns() Option {
+	return GlobalStore(rawLikePatternsKey, true)
+}
+
 func resolveBooleanOperator(op any) (BooleanOperator, error) {
 	switch string(op.([]byte)) {
 	case "AND", "and":
@@ -17,7 +56,8 @@ func resolveBooleanOperator(op any) (BooleanOperator, error) {
 }
 
 func resolveFieldOperator(op any) (FieldOperator, error) {
-	switch string(op.([]byte)) {
+	// Keywords like `not in` may be separated with any whitespace, so it's normalized to single space.
+	switch strings.ToLower(strings.Join(strings.Fields(string(op.([]byte))), " ")) {
 	case ">=":
 		return GreaterThanOrEqual, nil
 	case ">":
@@ -26,17 +66,29 @@ func resolveFieldOperator(op any) (FieldOperator, error) {
 		return LessThanOrEqual, nil
 	case "<":
 		return LessThan, nil
-	case "!:", "!=":
+	case "!:", "!=", "not in":
 		return NotEqual, nil
-	case ":", "=":
+	case ":", "=", "in":
 		return Equal, nil
 	case "~":
 		return Like, nil
+	case "~*":
+		return ILike, nil
 	default:
 		return 0, fmt.Errorf("unknown compare operator %q", op)
 	}
 }
 
+// spanOf returns span of the text matched by the current rule.
+func spanOf(c *current) Span {
+	return Span{Start: c.pos.offset, End: c.pos.offset + len(c.text)}
+}
+
+// sourceOf returns the text matched by the current rule.
+func sourceOf(c *current) source {
+	return source{text: string(c.text)}
+}
+
 func resolveOneOfValueType(val any) Valuer {
 	switch v := val.(type) {
 	case Identifier:
@@ -63,12 +115,23 @@ func parseBooleanExpression(left, rest any) (any, error) {
 			Left:  expr.(Expr),
 			Op:    op,
 			Right: right.(Expr),
+			Span:  Span{Start: expr.(Expr).Pos().Start, End: right.(Expr).Pos().End},
 		}
 	}
 	return expr, nil
 }
 
-func parseFieldExpression(field, op, value any) (any, error) {
+func parseNotExpression(c *current, expr any) (any, error) {
+	return &NotExpr{Expr: expr.(Expr), Span: spanOf(c)}, nil
+}
+
+// parseParenExpression extends span of the expression to the parentheses.
+func parseParenExpression(c *current, expr any) (any, error) {
+	expr.(interface{ setSpan(span Span) }).setSpan(spanOf(c))
+	return expr, nil
+}
+
+func parseFieldExpression(c *current, field, op, value any) (any, error) {
 	opR, err := resolveFieldOperator(op)
 	if err != nil {
 		return nil, err
@@ -90,35 +153,258 @@ func parseFieldExpression(field, op, value any) (any, error) {
 		Field: field.(Identifier),
 		Op:    opR,
 		Value: val.(Valuer),
+		Span:  spanOf(c),
 	}, nil
 }
 
+// parseComparison parses field expression and converts string values of `~` and `~*` into LIKE patterns when
+// RawLikePatterns option is set.
+func parseComparison(c *current, field, op, value any) (any, error) {
+	expr, err := parseFieldExpression(c, field, op, value)
+	if err != nil {
+		return nil, err
+	}
+
+	f := expr.(*FieldExpr)
+
+	if _, isNull := f.Value.(*NullLiteral); isNull && f.Op != Equal && f.Op != NotEqual {
+		return f, fmt.Errorf("null can only be compared with = and !=, got %q", f.Op)
+	}
+
+	if raw, _ := c.globalStore[rawLikePatternsKey].(bool); !raw || (f.Op != Like && f.Op != ILike) {
+		return f, nil
+	}
+
+	switch v := f.Value.(type) {
+	case *StringLiteral:
+		f.Value = &LikePatternLiteral{Pattern: v.StringValue, Span: v.Span}
+	case *OneOfExpr:
+		for i, val := range v.Values {
+			if s, isString := val.(*StringLiteral); isString {
+				v.Values[i] = &LikePatternLiteral{Pattern: s.StringValue, Span: s.Span}
+			}
+		}
+	}
+
+	return f, nil
+}
+
+func parseTermExpression(c *current, value any) (any, error) {
+	return &TermExpr{Term: value.(*StringLiteral).StringValue, Span: spanOf(c)}, nil
+}
+
+func parseExistsExpression(c *current, field any) (any, error) {
+	return &FieldExpr{
+		Field: field.(Identifier),
+		Op:    Exists,
+		Span:  spanOf(c),
+	}, nil
+}
+
+func parseRangeExpression(c *current, left, low, high, right any) (any, error) {
+	r := &RangeExpr{
+		LowExclusive:  left != nil && string(left.([]byte)) == "{",
+		HighExclusive: right != nil && string(right.([]byte)) == "}",
+		Span:          spanOf(c),
+	}
+
+	if low != nil {
+		r.Low = low.(Valuer)
+	}
+
+	if high != nil {
+		r.High = high.(Valuer)
+	}
+
+	// [* TO *] would mean different things in each backend, field existence is written as field:*.
+	if r.Low == nil && r.High == nil {
+		return r, errors.New("range must have at least one bound, use field:* to check that the field exists")
+	}
+
+	return r, nil
+}
+
 func parseNumber(c *current) (any, error) {
 	if val, err := strconv.Atoi(string(c.text)); err == nil {
-		return &IntegerLiteral{IntegerValue: int64(val)}, nil
+		return &IntegerLiteral{IntegerValue: int64(val), Span: spanOf(c), source: sourceOf(c)}, nil
 	}
 
 	if val, err := strconv.ParseFloat(string(c.text), 64); err == nil {
-		return &NumberLiteral{NumberValue: val}, nil
+		return &NumberLiteral{NumberValue: val, Span: spanOf(c), source: sourceOf(c)}, nil
 	}
 
 	return nil, fmt.Errorf("invalid number literal: %q", string(c.text))
 }
 
+func parseBoolean(c *current) (any, error) {
+	switch string(c.text) {
+	case "true", "TRUE":
+		return &BooleanLiteral{BooleanValue: true, Span: spanOf(c), source: sourceOf(c)}, nil
+	case "false", "FALSE":
+		return &BooleanLiteral{BooleanValue: false, Span: spanOf(c), source: sourceOf(c)}, nil
+	default:
+		return nil, fmt.Errorf("invalid boolean literal: %q", string(c.text))
+	}
+}
+
+func parseDateTime(c *current) (any, error) {
+	text := string(c.text)
+
+	val, err := parseTimeValue(text)
+	if err != nil {
+		// Parser keeps going after an action error, so zero literal is returned to keep the AST free of nil values.
+		return &TimeLiteral{Span: spanOf(c)}, fmt.Errorf("invalid date literal: %q: %w", text, err)
+	}
+
+	return &TimeLiteral{TimeValue: val, Span: spanOf(c), source: sourceOf(c)}, nil
+}
+
+// parseTimeValue parses RFC3339 date or date and time.
+func parseTimeValue(text string) (time.Time, error) {
+	layout := time.RFC3339Nano
+	if len(text) == len(time.DateOnly) {
+		layout = time.DateOnly
+	}
+
+	return time.Parse(layout, strings.ToUpper(text))
+}
+
+func parseRelativeTime(c *current) (any, error) {
+	now, _ := c.globalStore[clockKey].(func() time.Time)
+
+	offset, err := parseDurationValue(strings.TrimPrefix(string(c.text), "now"))
+	if err != nil {
+		return &RelativeTimeLiteral{Now: now, Span: spanOf(c)}, err
+	}
+
+	return &RelativeTimeLiteral{Offset: offset, Now: now, Span: spanOf(c), source: sourceOf(c)}, nil
+}
+
+func parseDuration(c *current) (any, error) {
+	val, err := parseDurationValue(string(c.text))
+	if err != nil {
+		return &DurationLiteral{Span: spanOf(c)}, err
+	}
+
+	return &DurationLiteral{DurationValue: val, Span: spanOf(c), source: sourceOf(c)}, nil
+}
+
+// parseDurationValue parses optionally signed sequence of numbers with units, e.g. -1d12h.
+func parseDurationValue(text string) (time.Duration, error) {
+	var (
+		sign  time.Duration = 1
+		total time.Duration
+		rest  = text
+	)
+
+	switch {
+	case strings.HasPrefix(rest, "-"):
+		sign, rest = -1, rest[1:]
+	case strings.HasPrefix(rest, "+"):
+		rest = rest[1:]
+	}
+
+	for rest != "" {
+		end := strings.IndexFunc(rest, func(r rune) bool { return r < '0' || r > '9' })
+		if end <= 0 {
+			return 0, fmt.Errorf("invalid duration literal: %q", text)
+		}
+
+		num, err := strconv.ParseInt(rest[:end], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration literal: %q: %w", text, err)
+		}
+
+		rest = rest[end:]
+
+		unit, unitLen := durationUnit(rest)
+		if unit == 0 {
+			return 0, fmt.Errorf("invalid duration literal: %q", text)
+		}
+
+		// Duration is int64 nanoseconds, so values beyond ~292 years wrap around.
+		if num > int64(math.MaxInt64/unit) || time.Duration(num)*unit > math.MaxInt64-total {
+			return 0, fmt.Errorf("invalid duration literal: %q: value out of range", text)
+		}
+
+		total += time.Duration(num) * unit
+		rest = rest[unitLen:]
+	}
+
+	return sign * total, nil
+}
+
+// durationUnit returns the unit the string starts with and its length.
+func durationUnit(s string) (time.Duration, int) {
+	var (
+		unit    time.Duration
+		nameLen int
+	)
+
+	for _, u := range durationUnits {
+		if strings.HasPrefix(s, u.name) && len(u.name) > nameLen {
+			unit, nameLen = u.unit, len(u.name)
+		}
+	}
+
+	return unit, nameLen
+}
+
+// formatDuration formats non-negative duration using units supported by the grammar, e.g. 1d12h.
+func formatDuration(d time.Duration) string {
+	if d == 0 {
+		return "0s"
+	}
+
+	var sb strings.Builder
+
+	for _, u := range durationUnits[1:] { // weeks are skipped in favor of days
+		if n := d / u.unit; n > 0 {
+			sb.WriteString(strconv.FormatInt(int64(n), 10))
+			sb.WriteString(u.name)
+			d -= n * u.unit
+		}
+	}
+
+	return sb.String()
+}
+
+func parseRegex(c *current) (any, error) {
+	text := string(c.text)
+	end := strings.LastIndexByte(text, '/')
+
+	lit := &RegexLiteral{Pattern: strings.ReplaceAll(text[1:end], `\/`, "/"), Span: spanOf(c)}
+
+	for _, flag := range text[end+1:] {
+		switch flag {
+		case 'i':
+			lit.CaseInsensitive = true
+		default:
+			return lit, fmt.Errorf("unknown regular expression flag %q", flag)
+		}
+	}
+
+	if err := lit.compile(); err != nil {
+		return lit, fmt.Errorf("invalid regular expression: %w", err)
+	}
+
+	return lit, nil
+}
+
 func parseString(c *current) (any, error) {
 	val, err := strconv.Unquote(string(c.text))
 	if err != nil {
 		return nil, err
 	}
-	return &StringLiteral{StringValue: val}, nil
+	return &StringLiteral{StringValue: val, Span: spanOf(c)}, nil
 }
 
-func parseOneOfExpression(values any) (any, error) {
+func parseOneOfExpression(c *current, values any) (any, error) {
 	if values == nil || len(values.([]Valuer)) == 0 {
-		return &OneOfExpr{Values: nil}, nil
+		return &OneOfExpr{Values: nil, Span: spanOf(c)}, nil
 	}
 
-	return &OneOfExpr{Values: values.([]Valuer)}, nil
+	return &OneOfExpr{Values: values.([]Valuer), Span: spanOf(c)}, nil
 }
 
 func parseOneOfValues(head, tail any) (any, error) {
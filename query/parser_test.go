@@ -2,6 +2,7 @@ package query_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/defer-panic/dumbql/query"
 	"github.com/stretchr/testify/require"
@@ -92,11 +93,191 @@ func TestParser(t *testing.T) { //nolint:funlen
 			input: "status : 200 and eps < 0.003 and (req.fields.ext:[\"jpg\", \"png\"])",
 			want:  "(and (and (= status 200) (< eps 0.003000)) (= req.fields.ext [\"jpg\" \"png\"]))",
 		},
+		// Boolean literal.
+		{
+			input: "is_active:true",
+			want:  "(= is_active true)",
+		},
+		// Uppercase boolean literal.
+		{
+			input: "verified != FALSE",
+			want:  "(!= verified false)",
+		},
+		// Identifier starting with a boolean keyword.
+		{
+			input: "title:true_story",
+			want:  "(= title \"true_story\")",
+		},
+		// Array of boolean literals.
+		{
+			input: "flags:[true, false]",
+			want:  "(= flags [true false])",
+		},
+		// Null literal.
+		{
+			input: "deleted_at = null",
+			want:  "(= deleted_at null)",
+		},
+		// Uppercase null literal with not-equals.
+		{
+			input: "manager_id != NULL",
+			want:  "(!= manager_id null)",
+		},
+		// Identifier starting with a null keyword.
+		{
+			input: "kind:nullable",
+			want:  "(= kind \"nullable\")",
+		},
+		// Field existence.
+		{
+			input: "email:*",
+			want:  "(exists email)",
+		},
+		// Field existence with equals sign and whitespace.
+		{
+			input: "profile.phone = * and not deleted_at:*",
+			want:  "(and (exists profile.phone) (not (exists deleted_at)))",
+		},
+		// Date literal.
+		{
+			input: "created_at >= 2024-01-01",
+			want:  "(>= created_at 2024-01-01T00:00:00Z)",
+		},
+		// Date and time literal with time zone offset.
+		{
+			input: "created_at < 2024-01-01T10:30:00.5+02:00",
+			want:  "(< created_at 2024-01-01T10:30:00.5+02:00)",
+		},
+		// Relative time literal.
+		{
+			input: "created_at > now-7d",
+			want:  "(> created_at now-7d)",
+		},
+		// Relative time literal without offset.
+		{
+			input: "expires_at <= now",
+			want:  "(<= expires_at now)",
+		},
+		// Duration literal.
+		{
+			input: "ttl > 1h30m",
+			want:  "(> ttl 1h30m)",
+		},
+		// Negative duration literal with weeks.
+		{
+			input: "offset = -2w",
+			want:  "(= offset -14d)",
+		},
+		// Array of date literals.
+		{
+			input: "day:[2024-01-01, now+1d]",
+			want:  "(= day [2024-01-01T00:00:00Z now+1d])",
+		},
+		// Identifier starting with a relative time keyword.
+		{
+			input: "name:nowhere",
+			want:  "(= name \"nowhere\")",
+		},
+		// Prefix wildcard.
+		{
+			input: "name:John*",
+			want:  "(= name John*)",
+		},
+		// Suffix and single character wildcards.
+		{
+			input: "name:*s?n",
+			want:  "(= name *s?n)",
+		},
+		// Case-insensitive like.
+		{
+			input: "name ~* john",
+			want:  "(~* name \"john\")",
+		},
+		// Regular expression with flag.
+		{
+			input: "name ~ /^jo.n$/i",
+			want:  "(~ name /^jo.n$/i)",
+		},
+		// Regular expression with escaped slash.
+		{
+			input: `path:/^\/usr\/.*/`,
+			want:  `(= path /^\/usr\/.*/)`,
+		},
+		// Inclusive range.
+		{
+			input: "age:[18 TO 65]",
+			want:  "(= age [18 TO 65])",
+		},
+		// Mixed range bounds.
+		{
+			input: "age!:{18 to 65]",
+			want:  "(!= age {18 TO 65])",
+		},
+		// Unbounded range.
+		{
+			input: "price:[* TO 9.99}",
+			want:  "(= price [* TO 9.990000})",
+		},
+		// Dotted range.
+		{
+			input: "age:18..65",
+			want:  "(= age [18 TO 65])",
+		},
+		// Dotted range of dates.
+		{
+			input: "created_at:2024-01-01..2024-01-31",
+			want:  "(= created_at [2024-01-01T00:00:00Z TO 2024-01-31T00:00:00Z])",
+		},
+		// Negated array literal.
+		{
+			input: "status != [closed, archived]",
+			want:  "(!= status [\"closed\" \"archived\"])",
+		},
+		// IN keyword.
+		{
+			input: "status in [open, pending]",
+			want:  "(= status [\"open\" \"pending\"])",
+		},
+		// NOT IN keywords.
+		{
+			input: "status NOT   IN [closed]",
+			want:  "(!= status [\"closed\"])",
+		},
+		// Field named as a keyword.
+		{
+			input: "in:5 and not:6",
+			want:  "(and (= in 5) (= not 6))",
+		},
 		// NOT with parentheses.
 		{
 			input: "not (status:200)",
 			want:  "(not (= status 200))",
 		},
+		// Fields starting with keywords.
+		{
+			input: "notes:1 and android:2 or order:3",
+			want:  "(or (and (= notes 1) (= android 2)) (= order 3))",
+		},
+		// Bare term.
+		{
+			input: "timeout",
+			want:  `(term "timeout")`,
+		},
+		// Quoted phrase combined with field expression.
+		{
+			input: `"broken pipe" and status:open`,
+			want:  `(and (term "broken pipe") (= status "open"))`,
+		},
+		// Negated terms.
+		{
+			input: "not v1.2-beta or not 404",
+			want:  `(or (not (term "v1.2-beta")) (not (term "404")))`,
+		},
+		// Term starting with a keyword.
+		{
+			input: "nothing and ordinary",
+			want:  `(and (term "nothing") (term "ordinary"))`,
+		},
 	}
 
 	for _, test := range tests {
@@ -108,3 +289,137 @@ func TestParser(t *testing.T) { //nolint:funlen
 		})
 	}
 }
+
+func TestParser_Error(t *testing.T) {
+	tests := []string{
+		// Date and time literal without time zone.
+		"created_at > 2024-01-01T10:30:00",
+		// Invalid date.
+		"created_at > 2024-13-01",
+		// Unknown duration unit.
+		"ttl > 1y",
+		// Trailing input.
+		"status:200 garbage",
+		// Trailing input after a number, used to be parsed as ip = 10.
+		"ip:10.0.0.1",
+		// Null with operators other than = and !=.
+		"name ~ null",
+		"age > NULL",
+		// Range without bounds.
+		"age:[* TO *]",
+		// Duration overflow.
+		"ttl < 106752d",
+		"created_at > now-300000w",
+		// Unbalanced parentheses.
+		"(status:200",
+		// Keyword as a term.
+		"status:open and and",
+		// Terms without boolean operator.
+		"broken pipe",
+		// Invalid regular expression.
+		"name ~ /(jo/",
+		// Unknown regular expression flag.
+		"name ~ /jo/x",
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			_, err := query.Parse("input", []byte(input))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestParser_InvalidLiteral(t *testing.T) {
+	tests := []struct {
+		input   string
+		wantErr string
+	}{
+		{input: "created_at > 2024-13-01", wantErr: `invalid date literal: "2024-13-01"`},
+		{input: "name ~* null", wantErr: `null can only be compared with = and !=, got "~*"`},
+		{input: "age:{* to *]", wantErr: "range must have at least one bound"},
+		{input: "ttl < 106752d", wantErr: `invalid duration literal: "106752d": value out of range`},
+		{input: "created_at > now-300000w", wantErr: `invalid duration literal: "-300000w": value out of range`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			_, err := query.Parse("input", []byte(test.input))
+			require.ErrorContains(t, err, test.wantErr)
+		})
+	}
+}
+
+func TestParser_RawLikePatterns(t *testing.T) {
+	ast, err := query.Parse("input", []byte(`name ~ ["J_hn%", "%son"] and title:"50%"`), query.RawLikePatterns())
+	require.NoError(t, err)
+
+	binaryExpr, isBinaryExpr := ast.(*query.BinaryExpr)
+	require.True(t, isBinaryExpr)
+
+	like, isFieldExpr := binaryExpr.Left.(*query.FieldExpr)
+	require.True(t, isFieldExpr)
+	require.Equal(t, &query.OneOfExpr{
+		Values: []query.Valuer{
+			&query.LikePatternLiteral{Pattern: "J_hn%", Span: query.Span{Start: 8, End: 15}},
+			&query.LikePatternLiteral{Pattern: "%son", Span: query.Span{Start: 17, End: 23}},
+		},
+		Span: query.Span{Start: 7, End: 24},
+	}, like.Value)
+
+	equal, isFieldExpr := binaryExpr.Right.(*query.FieldExpr)
+	require.True(t, isFieldExpr)
+	require.Equal(t, &query.StringLiteral{StringValue: "50%", Span: query.Span{Start: 35, End: 40}}, equal.Value)
+}
+
+func TestParser_Spans(t *testing.T) {
+	const input = `not (status:open or age:[18, 21]) and "broken pipe"`
+
+	ast, err := query.Parse("input", []byte(input))
+	require.NoError(t, err)
+
+	text := func(node interface{ Pos() query.Span }) string {
+		span := node.Pos()
+		return input[span.Start:span.End]
+	}
+
+	and := ast.(*query.BinaryExpr)
+	require.Equal(t, input, text(and))
+
+	not := and.Left.(*query.NotExpr)
+	require.Equal(t, `not (status:open or age:[18, 21])`, text(not))
+
+	or := not.Expr.(*query.BinaryExpr)
+	require.Equal(t, `(status:open or age:[18, 21])`, text(or))
+
+	status := or.Left.(*query.FieldExpr)
+	require.Equal(t, `status:open`, text(status))
+	require.Equal(t, `open`, text(status.Value.(*query.StringLiteral)))
+
+	age := or.Right.(*query.FieldExpr)
+	require.Equal(t, `age:[18, 21]`, text(age))
+
+	oneOf := age.Value.(*query.OneOfExpr)
+	require.Equal(t, `[18, 21]`, text(oneOf))
+	require.Equal(t, `21`, text(oneOf.Values[1].(*query.IntegerLiteral)))
+
+	term := and.Right.(*query.TermExpr)
+	require.Equal(t, `"broken pipe"`, text(term))
+}
+
+func TestParser_Clock(t *testing.T) {
+	now := time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	ast, err := query.Parse("input", []byte("created_at > now-1w2d"), query.Clock(clock))
+	require.NoError(t, err)
+
+	fieldExpr, isFieldExpr := ast.(*query.FieldExpr)
+	require.True(t, isFieldExpr)
+
+	relativeTime, isRelativeTime := fieldExpr.Value.(*query.RelativeTimeLiteral)
+	require.True(t, isRelativeTime)
+
+	require.Equal(t, -9*24*time.Hour, relativeTime.Offset)
+	require.Equal(t, time.Date(2024, 1, 22, 12, 0, 0, 0, time.UTC), relativeTime.Value())
+}
@@ -1,24 +1,45 @@
 package query
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	sq "github.com/Masterminds/squirrel"
 )
 
 func (b *BinaryExpr) ToSql() (string, []any, error) { //nolint:revive
+	return b.ToSqlDialect(Generic)
+}
+
+// ToSqlDialect converts the expression into SQL of the dialect, with placeholders in its format.
+func (b *BinaryExpr) ToSqlDialect(d Dialect) (string, []any, error) { //nolint:revive
+	sql, args, err := b.toSql(d)
+	return replacePlaceholders(d, sql, args, err)
+}
+
+func (b *BinaryExpr) toSql(d Dialect) (string, []any, error) { //nolint:revive
 	switch b.Op {
 	case And:
-		return sq.And{b.Left, b.Right}.ToSql()
+		return sq.And{WithDialect(b.Left, d), WithDialect(b.Right, d)}.ToSql()
 	case Or:
-		return sq.Or{b.Left, b.Right}.ToSql()
+		return sq.Or{WithDialect(b.Left, d), WithDialect(b.Right, d)}.ToSql()
 	}
 
 	return "", nil, fmt.Errorf("unknown operator %q", b.Op)
 }
 
 func (n *NotExpr) ToSql() (string, []any, error) { //nolint:revive
-	sql, args, err := n.Expr.ToSql()
+	return n.ToSqlDialect(Generic)
+}
+
+func (n *NotExpr) ToSqlDialect(d Dialect) (string, []any, error) { //nolint:revive
+	sql, args, err := n.toSql(d)
+	return replacePlaceholders(d, sql, args, err)
+}
+
+func (n *NotExpr) toSql(d Dialect) (string, []any, error) { //nolint:revive
+	sql, args, err := WithDialect(n.Expr, d).ToSql()
 	if err != nil {
 		return "", nil, err
 	}
@@ -26,8 +47,80 @@ func (n *NotExpr) ToSql() (string, []any, error) { //nolint:revive
 	return sq.Expr("NOT "+sql, args...).ToSql()
 }
 
+func (t *TermExpr) ToSql() (string, []any, error) { //nolint:revive
+	return t.ToSqlDialect(Generic)
+}
+
+// ToSqlDialect converts the term into OR of its field expressions. The term must be validated against schema first,
+// otherwise it's unknown which fields to search.
+func (t *TermExpr) ToSqlDialect(d Dialect) (string, []any, error) { //nolint:revive
+	sql, args, err := t.toSql(d)
+	return replacePlaceholders(d, sql, args, err)
+}
+
+func (t *TermExpr) toSql(d Dialect) (string, []any, error) { //nolint:revive
+	if len(t.Fields) == 0 {
+		return "", nil, fmt.Errorf("term %q: no fields to search", t.Term)
+	}
+
+	or := make(sq.Or, 0, len(t.Fields))
+	for _, f := range t.Fields {
+		or = append(or, WithDialect(f, d))
+	}
+
+	return or.ToSql()
+}
+
 func (f *FieldExpr) ToSql() (string, []any, error) { //nolint:revive
-	field, value := f.Field.String(), f.Value.Value()
+	return f.ToSqlDialect(Generic)
+}
+
+// ToSqlDialect converts the field expression into SQL predicate. The field name is quoted by the dialect, or rejected
+// if it can't be used safely.
+func (f *FieldExpr) ToSqlDialect(d Dialect) (string, []any, error) { //nolint:revive
+	sql, args, err := f.toSql(d)
+	return replacePlaceholders(d, sql, args, err)
+}
+
+func (f *FieldExpr) toSql(d Dialect) (string, []any, error) { //nolint:revive,cyclop
+	field, err := d.QuoteIdentifier(f.Field.String())
+	if err != nil {
+		return "", nil, err
+	}
+
+	if f.Op == Exists {
+		return sq.NotEq{field: nil}.ToSql()
+	}
+
+	if f.Op != Like && f.Op != ILike {
+		field = d.Cast(f.Field.String(), field, f.comparedValue())
+	}
+
+	switch v := f.Value.(type) {
+	case *RangeExpr:
+		return rangeToSql(field, v, f.Op)
+	case *OneOfExpr:
+		return oneOfToSql(field, f, v, d)
+	case *WildcardLiteral:
+		return patternToSql(likePredicate(d, field, v.LikePattern(), f.Op), f.Op)
+	case *LikePatternLiteral:
+		return patternToSql(likePredicate(d, field, v.Pattern, f.Op), f.Op)
+	case *RegexLiteral:
+		return patternToSql(d.Regexp(field, v.Pattern, v.CaseInsensitive || f.Op == ILike), f.Op)
+	case *StringLiteral:
+		if v.CaseInsensitive && (f.Op == Equal || f.Op == NotEqual) {
+			return foldedEqualToSql(field, v.StringValue, f.Op)
+		}
+	}
+
+	value := f.Value.Value()
+	if value == nil && f.Op != Equal && f.Op != NotEqual {
+		return "", nil, fmt.Errorf("operator %q is not supported for null", f.Op)
+	}
+
+	if b, isBool := value.(bool); isBool {
+		value = d.BoolValue(b)
+	}
 
 	var sqlizer sq.Sqlizer
 
@@ -45,10 +138,180 @@ func (f *FieldExpr) ToSql() (string, []any, error) { //nolint:revive
 	case LessThanOrEqual:
 		sqlizer = sq.LtOrEq{field: value}
 	case Like:
-		sqlizer = sq.Like{field: value}
+		sqlizer = d.Like(field, containsPattern(value))
+	case ILike:
+		sqlizer = d.ILike(field, containsPattern(value))
 	default:
 		return "", nil, fmt.Errorf("unknown operator %q", f.Op)
 	}
 
 	return sqlizer.ToSql()
 }
+
+// comparedValue returns the value which defines type of the comparison: a bound of range or the first value of one-of.
+func (f *FieldExpr) comparedValue() any {
+	switch v := f.Value.(type) {
+	case *RangeExpr:
+		if v.Low != nil {
+			return v.Low.Value()
+		}
+
+		if v.High != nil {
+			return v.High.Value()
+		}
+
+		return nil
+	case *OneOfExpr:
+		if len(v.Values) == 0 {
+			return nil
+		}
+
+		return v.Values[0].Value()
+	default:
+		return f.Value.Value()
+	}
+}
+
+// patternToSql converts wildcard or regular expression predicate according to the operator. `=`, `~` and `~*` mean
+// that the field matches the pattern, and `!=` negates it.
+func patternToSql(predicate sq.Sqlizer, op FieldOperator) (string, []any, error) { //nolint:revive
+	switch op { //nolint:exhaustive
+	case Equal, Like, ILike:
+		return predicate.ToSql()
+	case NotEqual:
+		sql, args, err := predicate.ToSql()
+		if err != nil {
+			return "", nil, err
+		}
+
+		return sq.Expr("NOT ("+sql+")", args...).ToSql()
+	default:
+		return "", nil, fmt.Errorf("operator %q is not supported for pattern", op)
+	}
+}
+
+// likePredicate returns case-insensitive LIKE predicate for `~*` operator and case-sensitive one otherwise.
+func likePredicate(d Dialect, field, pattern string, op FieldOperator) sq.Sqlizer {
+	if op == ILike {
+		return d.ILike(field, pattern)
+	}
+
+	return d.Like(field, pattern)
+}
+
+// oneOfToSql converts one-of into IN list for `=` and `!=`, or into OR of LIKE predicates for `~` and `~*`.
+func oneOfToSql(field string, f *FieldExpr, oneOf *OneOfExpr, d Dialect) (string, []any, error) { //nolint:revive
+	switch f.Op { //nolint:exhaustive
+	case Like, ILike:
+		return oneOfPatternsToSql(f, oneOf, d)
+	case Equal, NotEqual:
+		values := make([]any, 0, len(oneOf.Values))
+		folded := false
+
+		for _, v := range oneOf.Values {
+			value := v.Value()
+			if b, isBool := value.(bool); isBool {
+				value = d.BoolValue(b)
+			}
+			if s, isString := v.(*StringLiteral); isString && s.CaseInsensitive {
+				value, folded = strings.ToLower(s.StringValue), true
+			}
+			values = append(values, value)
+		}
+
+		if folded {
+			field = "LOWER(" + field + ")"
+		}
+
+		return d.In(f.Field.String(), field, values, f.Op == NotEqual).ToSql()
+	default:
+		return "", nil, fmt.Errorf("operator %q is not supported for one-of", f.Op)
+	}
+}
+
+// oneOfPatternsToSql converts `~` and `~*` with one-of into OR of LIKE predicates, matching any of the values.
+func oneOfPatternsToSql(f *FieldExpr, oneOf *OneOfExpr, d Dialect) (string, []any, error) { //nolint:revive
+	or := make(sq.Or, 0, len(oneOf.Values))
+
+	for _, v := range oneOf.Values {
+		or = append(or, WithDialect(&FieldExpr{Field: f.Field, Op: f.Op, Value: v}, d))
+	}
+
+	return or.ToSql()
+}
+
+// foldedEqualToSql compares lower-cased column and value.
+func foldedEqualToSql(field, value string, op FieldOperator) (string, []any, error) { //nolint:revive
+	cmp := " = "
+	if op == NotEqual {
+		cmp = " <> "
+	}
+
+	return sq.Expr("LOWER("+field+")"+cmp+"LOWER(?)", value).ToSql()
+}
+
+// containsPattern converts the value into LIKE pattern matching any string which contains it.
+func containsPattern(value any) string {
+	return "%" + escapeLike(fmt.Sprint(value)) + "%"
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// escapeLike escapes LIKE wildcards and escape character itself with backslash.
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+// rangeToSql converts the range into BETWEEN when both bounds are inclusive, or into a pair of comparisons otherwise.
+func rangeToSql(field string, r *RangeExpr, op FieldOperator) (string, []any, error) { //nolint:revive
+	if op != Equal && op != NotEqual {
+		return "", nil, fmt.Errorf("operator %q is not supported for range", op)
+	}
+
+	if r.Low == nil && r.High == nil {
+		return "", nil, errors.New("range must have at least one bound")
+	}
+
+	if r.Low != nil && r.High != nil && !r.LowExclusive && !r.HighExclusive {
+		between := " BETWEEN ? AND ?"
+		if op == NotEqual {
+			between = " NOT BETWEEN ? AND ?"
+		}
+
+		return sq.Expr(field+between, r.Low.Value(), r.High.Value()).ToSql()
+	}
+
+	bounds := make(sq.And, 0, 2) //nolint:mnd
+
+	if r.Low != nil {
+		if r.LowExclusive {
+			bounds = append(bounds, sq.Gt{field: r.Low.Value()})
+		} else {
+			bounds = append(bounds, sq.GtOrEq{field: r.Low.Value()})
+		}
+	}
+
+	if r.High != nil {
+		if r.HighExclusive {
+			bounds = append(bounds, sq.Lt{field: r.High.Value()})
+		} else {
+			bounds = append(bounds, sq.LtOrEq{field: r.High.Value()})
+		}
+	}
+
+	var sqlizer sq.Sqlizer = bounds
+	if len(bounds) == 1 {
+		sqlizer = bounds[0]
+	}
+
+	sql, args, err := sqlizer.ToSql()
+	if err != nil {
+		return "", nil, err
+	}
+
+	if op == NotEqual {
+		return sq.Expr("NOT "+sql, args...).ToSql()
+	}
+
+	return sql, args, nil
+}
@@ -1,9 +1,13 @@
 package query_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/defer-panic/dumbql/query"
+	"github.com/defer-panic/dumbql/schema"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	sq "github.com/Masterminds/squirrel"
@@ -57,6 +61,18 @@ func TestToSql(t *testing.T) { //nolint:funlen
 			want:     "SELECT * FROM dummy_table WHERE req.fields.ext IN (?,?)",
 			wantArgs: []any{"jpg", "png"},
 		},
+		{
+			// Negated array literal conversion (using NOT IN).
+			input:    "status != [closed, archived]",
+			want:     "SELECT * FROM dummy_table WHERE status NOT IN (?,?)",
+			wantArgs: []any{"closed", "archived"},
+		},
+		{
+			// NOT IN keywords.
+			input:    "status not in [closed]",
+			want:     "SELECT * FROM dummy_table WHERE status NOT IN (?)",
+			wantArgs: []any{"closed"},
+		},
 		{
 			// Complex expression combining AND and a parenthesized array literal.
 			input:    "status:200 and eps<0.003 and (req.fields.ext:[\"jpg\", \"png\"])",
@@ -82,11 +98,105 @@ func TestToSql(t *testing.T) { //nolint:funlen
 			wantArgs: []any{int64(200), 0.003},
 		},
 		{
-			input: `name~"John"`,
-			want:  "SELECT * FROM dummy_table WHERE name LIKE ?",
-			wantArgs: []any{
-				"John",
-			},
+			// Boolean literal.
+			input:    "is_active:true",
+			want:     "SELECT * FROM dummy_table WHERE is_active = ?",
+			wantArgs: []any{true},
+		},
+		{
+			// Null literal (using IS NULL).
+			input:    "deleted_at = null",
+			want:     "SELECT * FROM dummy_table WHERE deleted_at IS NULL",
+			wantArgs: nil,
+		},
+		{
+			// Null literal (using IS NOT NULL).
+			input:    "manager_id != null",
+			want:     "SELECT * FROM dummy_table WHERE manager_id IS NOT NULL",
+			wantArgs: nil,
+		},
+		{
+			// Field existence (using IS NOT NULL).
+			input:    "email:*",
+			want:     "SELECT * FROM dummy_table WHERE email IS NOT NULL",
+			wantArgs: nil,
+		},
+		{
+			// Date literal.
+			input:    "created_at >= 2024-01-01",
+			want:     "SELECT * FROM dummy_table WHERE created_at >= ?",
+			wantArgs: []any{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			// Duration literal.
+			input:    "ttl < 1h30m",
+			want:     "SELECT * FROM dummy_table WHERE ttl < ?",
+			wantArgs: []any{90 * time.Minute},
+		},
+		{
+			// Inclusive range (using BETWEEN).
+			input:    "age:[18 TO 65]",
+			want:     "SELECT * FROM dummy_table WHERE age BETWEEN ? AND ?",
+			wantArgs: []any{int64(18), int64(65)},
+		},
+		{
+			// Negated inclusive range (using NOT BETWEEN).
+			input:    "age!=18..65",
+			want:     "SELECT * FROM dummy_table WHERE age NOT BETWEEN ? AND ?",
+			wantArgs: []any{int64(18), int64(65)},
+		},
+		{
+			// Range with exclusive bound (using pair of comparisons).
+			input:    "age:[18 TO 65}",
+			want:     "SELECT * FROM dummy_table WHERE (age >= ? AND age < ?)",
+			wantArgs: []any{int64(18), int64(65)},
+		},
+		{
+			// Range unbounded from one side.
+			input:    "age:{18 TO *]",
+			want:     "SELECT * FROM dummy_table WHERE age > ?",
+			wantArgs: []any{int64(18)},
+		},
+		{
+			// Prefix wildcard, LIKE special characters are escaped.
+			input:    "name:John_*",
+			want:     `SELECT * FROM dummy_table WHERE name LIKE ? ESCAPE '\'`,
+			wantArgs: []any{`John\_%`},
+		},
+		{
+			// Negated wildcard.
+			input:    "name!:*s?n",
+			want:     `SELECT * FROM dummy_table WHERE NOT (name LIKE ? ESCAPE '\')`,
+			wantArgs: []any{"%s_n"},
+		},
+		{
+			input:    "name ~ /^jo.n$/i",
+			want:     "SELECT * FROM dummy_table WHERE name REGEXP ?",
+			wantArgs: []any{"(?i)^jo.n$"},
+		},
+		{
+			// Case-insensitive contains, LIKE special characters are escaped.
+			input:    `name ~* "50%"`,
+			want:     `SELECT * FROM dummy_table WHERE LOWER(name) LIKE LOWER(?) ESCAPE '\'`,
+			wantArgs: []any{`%50\%%`},
+		},
+		{
+			// Like means contains.
+			input:    `name~"John"`,
+			want:     `SELECT * FROM dummy_table WHERE name LIKE ? ESCAPE '\'`,
+			wantArgs: []any{"%John%"},
+		},
+		{
+			// LIKE special characters are escaped.
+			input:    `discount ~ "50%_off\\"`,
+			want:     `SELECT * FROM dummy_table WHERE discount LIKE ? ESCAPE '\'`,
+			wantArgs: []any{`%50\%\_off\\%`},
+		},
+		{
+			// Like with one of.
+			input:    `name ~ [john, jane]`,
+			want:     `SELECT * FROM dummy_table WHERE (name LIKE ? ESCAPE '\' OR name LIKE ? ESCAPE '\')`,
+			wantArgs: []any{"%john%", "%jane%"},
 		},
 	}
 
@@ -106,3 +216,557 @@ func TestToSql(t *testing.T) { //nolint:funlen
 		})
 	}
 }
+
+func TestToSql_RelativeTime(t *testing.T) {
+	now := time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)
+
+	ast, err := query.Parse("test", []byte("created_at > now-7d"), query.Clock(func() time.Time { return now }))
+	require.NoError(t, err)
+
+	got, gotArgs, err := ast.(query.Expr).ToSql()
+	require.NoError(t, err)
+	require.Equal(t, "created_at > ?", got)
+	require.Equal(t, []any{time.Date(2024, 1, 24, 12, 0, 0, 0, time.UTC)}, gotArgs)
+}
+
+func TestToSqlDialect(t *testing.T) { //nolint:funlen
+	tests := []struct {
+		name     string
+		dialect  query.Dialect
+		input    string
+		raw      bool
+		want     string
+		wantArgs []any
+	}{
+		{
+			name:     "postgres wildcard",
+			dialect:  query.PostgreSQL,
+			input:    "name:John*",
+			want:     `"name" LIKE ?`,
+			wantArgs: []any{"John%"},
+		},
+		{
+			name:     "postgres case-insensitive like",
+			dialect:  query.PostgreSQL,
+			input:    "name ~* john",
+			want:     `"name" ILIKE ?`,
+			wantArgs: []any{"%john%"},
+		},
+		{
+			name:     "mysql case-insensitive like",
+			dialect:  query.MySQL,
+			input:    "name ~* john",
+			want:     "LOWER(`name`) LIKE LOWER(?)",
+			wantArgs: []any{"%john%"},
+		},
+		{
+			name:     "postgres case-insensitive wildcard",
+			dialect:  query.PostgreSQL,
+			input:    "name ~* John*",
+			want:     `"name" ILIKE ?`,
+			wantArgs: []any{"John%"},
+		},
+		{
+			name:     "postgres regex",
+			dialect:  query.PostgreSQL,
+			input:    "name ~ /^jo.n$/",
+			want:     `"name" ~ ?`,
+			wantArgs: []any{"^jo.n$"},
+		},
+		{
+			name:     "postgres case-insensitive regex",
+			dialect:  query.PostgreSQL,
+			input:    "name!:/^jo.n$/i",
+			want:     `NOT ("name" ~* ?)`,
+			wantArgs: []any{"^jo.n$"},
+		},
+		{
+			name:     "mysql regex",
+			dialect:  query.MySQL,
+			input:    "name ~ /^jo.n$/i",
+			want:     "REGEXP_LIKE(`name`, ?, 'i')",
+			wantArgs: []any{"^jo.n$"},
+		},
+		{
+			name:     "sqlite wildcard",
+			dialect:  query.SQLite,
+			input:    "name:*son",
+			want:     `"name" GLOB ?`,
+			wantArgs: []any{"*son"},
+		},
+		{
+			name:     "sqlite like",
+			dialect:  query.SQLite,
+			input:    `name ~ "50%_[a]*?\\"`,
+			want:     `"name" GLOB ?`,
+			wantArgs: []any{`*50%_[[]a][*][?]\*`},
+		},
+		{
+			name:     "sqlite raw like pattern",
+			dialect:  query.SQLite,
+			input:    `name ~ "J_hn\\%%"`,
+			raw:      true,
+			want:     `"name" GLOB ?`,
+			wantArgs: []any{`J?hn%*`},
+		},
+		{
+			name:     "sqlite case-insensitive like",
+			dialect:  query.SQLite,
+			input:    "name ~* john",
+			want:     `LOWER("name") LIKE LOWER(?) ESCAPE '\'`,
+			wantArgs: []any{"%john%"},
+		},
+		{
+			name:     "mysql like",
+			dialect:  query.MySQL,
+			input:    "name ~ john",
+			want:     "`name` LIKE CAST(? AS BINARY)",
+			wantArgs: []any{"%john%"},
+		},
+		{
+			name:     "sqlserver like",
+			dialect:  query.SQLServer,
+			input:    "name ~ john",
+			want:     `[name] COLLATE Latin1_General_CS_AS LIKE ? ESCAPE '\'`,
+			wantArgs: []any{"%john%"},
+		},
+		{
+			name:     "sqlserver case-insensitive like",
+			dialect:  query.SQLServer,
+			input:    "name ~* john",
+			want:     `LOWER([name]) LIKE LOWER(?) ESCAPE '\'`,
+			wantArgs: []any{"%john%"},
+		},
+		{
+			name:     "postgres dotted field",
+			dialect:  query.PostgreSQL,
+			input:    "profile.age >= 18",
+			want:     `"profile"."age" >= ?`,
+			wantArgs: []any{int64(18)},
+		},
+		{
+			name:     "mysql range",
+			dialect:  query.MySQL,
+			input:    "profile.age:[18 TO 65]",
+			want:     "`profile`.`age` BETWEEN ? AND ?",
+			wantArgs: []any{int64(18), int64(65)},
+		},
+		{
+			name:     "clickhouse regex in compound expression",
+			dialect:  query.ClickHouse,
+			input:    "status:200 and name ~ /^jo.n$/",
+			want:     "(`status` = ? AND match(`name`, ?))",
+			wantArgs: []any{int64(200), "^jo.n$"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var opts []query.Option
+			if test.raw {
+				opts = append(opts, query.RawLikePatterns())
+			}
+
+			ast, err := query.Parse("test", []byte(test.input), opts...)
+			require.NoError(t, err)
+
+			got, gotArgs, err := query.WithDialect(ast.(query.Expr), test.dialect).ToSql()
+			require.NoError(t, err)
+			require.Equal(t, test.want, got)
+			require.Equal(t, test.wantArgs, gotArgs)
+		})
+	}
+}
+
+func TestToSqlDialect_Placeholders(t *testing.T) { //nolint:funlen
+	tests := []struct {
+		name     string
+		dialect  query.Dialect
+		input    string
+		want     string
+		wantArgs []any
+	}{
+		{
+			name:     "generic",
+			dialect:  query.Generic,
+			input:    "status:open and not is_active:true",
+			want:     "(status = ? AND NOT is_active = ?)",
+			wantArgs: []any{"open", true},
+		},
+		{
+			name:     "postgres",
+			dialect:  query.PostgreSQL,
+			input:    "status:[open, pending] and (name ~ john or not is_active:true)",
+			want:     `("status" IN ($1,$2) AND ("name" LIKE $3 OR NOT "is_active" = $4))`,
+			wantArgs: []any{"open", "pending", "%john%", true},
+		},
+		{
+			name:     "sqlite boolean",
+			dialect:  query.SQLite,
+			input:    "is_active:false or flags != [true, false]",
+			want:     `("is_active" = ? OR "flags" NOT IN (?,?))`,
+			wantArgs: []any{int64(0), int64(1), int64(0)},
+		},
+		{
+			name:     "sqlserver",
+			dialect:  query.SQLServer,
+			input:    "age:[18 TO 65] and is_active:true",
+			want:     "([age] BETWEEN @p1 AND @p2 AND [is_active] = @p3)",
+			wantArgs: []any{int64(18), int64(65), int64(1)},
+		},
+		{
+			name:     "mysql",
+			dialect:  query.MySQL,
+			input:    "status:open and is_active:true",
+			want:     "(`status` = ? AND `is_active` = ?)",
+			wantArgs: []any{"open", true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ast, err := query.Parse("test", []byte(test.input))
+			require.NoError(t, err)
+
+			got, gotArgs, err := ast.(query.Expr).ToSqlDialect(test.dialect)
+			require.NoError(t, err)
+			require.Equal(t, test.want, got)
+			require.Equal(t, test.wantArgs, gotArgs)
+		})
+	}
+}
+
+func TestToSqlDialect_JSONB(t *testing.T) { //nolint:funlen
+	dialect := query.PostgreSQLJSONB(schema.Fields{
+		"id":          {},
+		"name":        {JSONColumn: "data"},
+		"is_active":   {JSONColumn: "data"},
+		"created_at":  {JSONColumn: "data"},
+		"deleted_at":  {JSONColumn: "data"},
+		"email":       {JSONColumn: "data", CaseInsensitive: true},
+		"status":      {JSONColumn: "data"},
+		"score":       {JSONColumn: "data"},
+		"profile.age": {JSONColumn: "t.data"},
+		"o'name":      {JSONColumn: "data"},
+	})
+
+	tests := []struct {
+		name     string
+		dialect  query.Dialect
+		input    string
+		want     string
+		wantArgs []any
+	}{
+		{
+			name:     "number",
+			dialect:  dialect,
+			input:    "profile.age >= 18",
+			want:     `("t"."data"->'profile'->>'age')::numeric >= $1`,
+			wantArgs: []any{int64(18)},
+		},
+		{
+			name:     "string and boolean",
+			dialect:  dialect,
+			input:    "name:John and is_active:true",
+			want:     `("data"->>'name' = $1 AND ("data"->>'is_active')::boolean = $2)`,
+			wantArgs: []any{"John", true},
+		},
+		{
+			name:     "date range",
+			dialect:  dialect,
+			input:    "created_at:[2024-01-01 TO *]",
+			want:     `("data"->>'created_at')::timestamptz >= $1`,
+			wantArgs: []any{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:     "one of",
+			dialect:  dialect,
+			input:    "profile.age:[18, 21] and status != [open, closed]",
+			want:     `(("t"."data"->'profile'->>'age')::numeric = ANY($1) AND "data"->>'status' <> ALL($2))`,
+			wantArgs: []any{[]int64{18, 21}, []string{"open", "closed"}},
+		},
+		{
+			name:     "one of numbers",
+			dialect:  dialect,
+			input:    "score:[1, 2.5]",
+			want:     `("data"->>'score')::numeric = ANY($1)`,
+			wantArgs: []any{[]float64{1, 2.5}},
+		},
+		{
+			name:     "empty one of",
+			dialect:  dialect,
+			input:    "status:[]",
+			want:     `(1=0)`,
+			wantArgs: []any{},
+		},
+		{
+			name:     "like, null and exists",
+			dialect:  dialect,
+			input:    "name ~ jo and deleted_at:null and email:*",
+			want:     `(("data"->>'name' LIKE $1 AND "data"->>'deleted_at' IS NULL) AND "data"->>'email' IS NOT NULL)`,
+			wantArgs: []any{"%jo%"},
+		},
+		{
+			name:     "regular columns",
+			dialect:  dialect,
+			input:    "id:[1, 2] and profile.age > 18 and unknown:x",
+			want:     `(("id" IN ($1,$2) AND ("t"."data"->'profile'->>'age')::numeric > $3) AND "unknown" = $4)`,
+			wantArgs: []any{int64(1), int64(2), int64(18), "x"},
+		},
+		{
+			name:    "mapped field",
+			dialect: query.RestrictFields(dialect, query.FieldMap{"age": "profile.age", "name": "id"}),
+			input:   "age:[18, 21] and age > 30 and name:[1, 2]",
+			want: `((("t"."data"->'profile'->>'age')::numeric = ANY($1) AND ` +
+				`("t"."data"->'profile'->>'age')::numeric > $2) AND "id" IN ($3,$4))`,
+			wantArgs: []any{[]int64{18, 21}, int64(30), int64(1), int64(2)},
+		},
+		{
+			name:     "quote in key",
+			dialect:  query.RestrictFields(dialect, query.FieldMap{"name": "o'name"}),
+			input:    "name:x",
+			want:     `"data"->>'o''name' = $1`,
+			wantArgs: []any{"x"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ast, err := query.Parse("test", []byte(test.input))
+			require.NoError(t, err)
+
+			got, gotArgs, err := ast.(query.Expr).ToSqlDialect(test.dialect)
+			require.NoError(t, err)
+			require.Equal(t, test.want, got)
+			require.Equal(t, test.wantArgs, gotArgs)
+		})
+	}
+}
+
+func TestToSqlDialect_JSONB_CaseInsensitive(t *testing.T) {
+	fields := schema.Fields{"email": {JSONColumn: "data", CaseInsensitive: true}}
+
+	ast, err := query.Parse("test", []byte(`email:["John@example.com", "Jane@example.com"]`))
+	require.NoError(t, err)
+
+	expr, err := ast.(query.Expr).Validate(fields)
+	require.NoError(t, err)
+
+	got, gotArgs, err := expr.ToSqlDialect(query.PostgreSQLJSONB(fields))
+	require.NoError(t, err)
+	assert.Equal(t, `LOWER("data"->>'email') = ANY($1)`, got)
+	assert.Equal(t, []any{[]string{"john@example.com", "jane@example.com"}}, gotArgs)
+}
+
+func TestToSqlDialect_JSONB_Error(t *testing.T) {
+	dialect := query.PostgreSQLJSONB(schema.Fields{"status": {JSONColumn: "data"}, "a?b": {JSONColumn: "data"}})
+
+	tests := []struct {
+		name    string
+		expr    query.Expr
+		wantErr string
+	}{
+		{
+			name: "mixed one of",
+			expr: &query.FieldExpr{Field: "status", Op: query.Equal, Value: &query.OneOfExpr{
+				Values: []query.Valuer{&query.StringLiteral{StringValue: "open"}, &query.IntegerLiteral{IntegerValue: 1}},
+			}},
+			wantErr: "one-of values must have the same type, got string and int64",
+		},
+		{
+			name:    "placeholder in key",
+			expr:    &query.FieldExpr{Field: "a?b", Op: query.Equal, Value: &query.IntegerLiteral{IntegerValue: 1}},
+			wantErr: `invalid identifier "a?b"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, err := test.expr.ToSqlDialect(dialect)
+			require.ErrorContains(t, err, test.wantErr)
+		})
+	}
+}
+
+func TestWithDialect_Builder(t *testing.T) {
+	ast, err := query.Parse("test", []byte("status:open and age > 18"))
+	require.NoError(t, err)
+
+	got, gotArgs, err := sq.Select("*").
+		From("users").
+		Where("tenant_id = ?", 42).
+		Where(query.WithDialect(ast.(query.Expr), query.PostgreSQL)).
+		PlaceholderFormat(query.PostgreSQL.PlaceholderFormat()).
+		ToSql()
+	require.NoError(t, err)
+	require.Equal(t, `SELECT * FROM users WHERE tenant_id = $1 AND ("status" = $2 AND "age" > $3)`, got)
+	require.Equal(t, []any{42, "open", int64(18)}, gotArgs)
+}
+
+func TestQuoteIdentifier(t *testing.T) {
+	tests := []struct {
+		dialect query.Dialect
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{dialect: query.Generic, name: "u.email", want: "u.email"},
+		{dialect: query.Generic, name: "email; DROP TABLE users", wantErr: true},
+		{dialect: query.Generic, name: "1st", wantErr: true},
+		{dialect: query.PostgreSQL, name: "u.email", want: `"u"."email"`},
+		{dialect: query.PostgreSQL, name: `a"b`, want: `"a""b"`},
+		{dialect: query.SQLite, name: "email", want: `"email"`},
+		{dialect: query.MySQL, name: "a`b", want: "`a``b`"},
+		{dialect: query.ClickHouse, name: "u.email", want: "`u`.`email`"},
+		{dialect: query.SQLServer, name: "a]b.c", want: "[a]]b].[c]"},
+		{dialect: query.PostgreSQL, name: "u..email", wantErr: true},
+		{dialect: query.PostgreSQL, name: "", wantErr: true},
+		{dialect: query.PostgreSQL, name: "a?b", wantErr: true},
+		{dialect: query.MySQL, name: "u.?", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%T %s", test.dialect, test.name), func(t *testing.T) {
+			got, err := test.dialect.QuoteIdentifier(test.name)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestToSql_InvalidIdentifier(t *testing.T) {
+	expr := &query.FieldExpr{
+		Field: "name = name OR 1",
+		Op:    query.Equal,
+		Value: &query.IntegerLiteral{IntegerValue: 1},
+	}
+
+	_, _, err := expr.ToSql()
+	require.EqualError(t, err, `invalid identifier "name = name OR 1"`)
+
+	got, _, err := query.WithDialect(expr, query.PostgreSQL).ToSql()
+	require.NoError(t, err)
+	require.Equal(t, `"name = name OR 1" = ?`, got)
+}
+
+func TestRestrictFields(t *testing.T) {
+	ast, err := query.Parse("test", []byte(`status:open and (name:John or secret:*)`))
+	require.NoError(t, err)
+
+	expr := ast.(query.Expr)
+
+	allowed := query.RestrictFields(query.PostgreSQL, query.AllowFields("status", "name"))
+
+	_, _, err = query.WithDialect(expr, allowed).ToSql()
+	require.EqualError(t, err, `field "secret" not found in schema`)
+
+	dialect := query.RestrictFields(query.PostgreSQL, query.FieldMap{
+		"status": "u.status",
+		"name":   "u.name",
+		"secret": "u.secret",
+	})
+
+	got, gotArgs, err := query.WithDialect(expr, dialect).ToSql()
+	require.NoError(t, err)
+	require.Equal(t, `("u"."status" = ? AND ("u"."name" = ? OR "u"."secret" IS NOT NULL))`, got)
+	require.Equal(t, []any{"open", "John"}, gotArgs)
+}
+
+func TestToSql_RawLikePatterns(t *testing.T) {
+	tests := []struct {
+		input    string
+		want     string
+		wantArgs []any
+	}{
+		{
+			input:    `name ~ "J_hn%"`,
+			want:     `name LIKE ? ESCAPE '\'`,
+			wantArgs: []any{"J_hn%"},
+		},
+		{
+			input:    `name ~* "j_hn%"`,
+			want:     `LOWER(name) LIKE LOWER(?) ESCAPE '\'`,
+			wantArgs: []any{"j_hn%"},
+		},
+		{
+			// Only like operators are affected.
+			input:    `name:"J_hn%"`,
+			want:     "name = ?",
+			wantArgs: []any{"J_hn%"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			ast, err := query.Parse("test", []byte(test.input), query.RawLikePatterns())
+			require.NoError(t, err)
+
+			got, gotArgs, err := ast.(query.Expr).ToSql()
+			require.NoError(t, err)
+			require.Equal(t, test.want, got)
+			require.Equal(t, test.wantArgs, gotArgs)
+		})
+	}
+}
+
+func TestToSql_Term(t *testing.T) {
+	fields := schema.Fields{
+		"title":  {Searchable: true},
+		"body":   {Searchable: true, CaseInsensitive: true},
+		"status": {},
+	}
+
+	ast, err := query.Parse("test", []byte(`"broken pipe" and status:open`))
+	require.NoError(t, err)
+
+	expr, err := ast.(query.Expr).Validate(fields)
+	require.NoError(t, err)
+
+	got, gotArgs, err := query.WithDialect(expr, query.PostgreSQL).ToSql()
+	require.NoError(t, err)
+	require.Equal(t, `(("body" ILIKE ? OR "title" LIKE ?) AND "status" = ?)`, got)
+	require.Equal(t, []any{"%broken pipe%", "%broken pipe%", "open"}, gotArgs)
+
+	_, _, err = ast.(query.Expr).ToSql()
+	require.Error(t, err, "term is not validated")
+}
+
+func TestToSql_Error(t *testing.T) {
+	tests := []string{
+		// Range with comparison operator.
+		"age > [18 TO 65]",
+		// Pattern with comparison operator.
+		"name > John*",
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			ast, err := query.Parse("test", []byte(input))
+			require.NoError(t, err)
+
+			_, _, err = ast.(query.Expr).ToSql()
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestToSql_NullOperator(t *testing.T) {
+	for _, op := range []query.FieldOperator{query.Like, query.ILike, query.GreaterThan, query.LessThanOrEqual} {
+		expr := &query.FieldExpr{Field: "name", Op: op, Value: &query.NullLiteral{}}
+
+		_, _, err := expr.ToSql()
+		require.Error(t, err, op.String())
+	}
+}
+
+func TestToSql_UnboundedRange(t *testing.T) {
+	expr := &query.FieldExpr{Field: "age", Op: query.Equal, Value: &query.RangeExpr{}}
+
+	_, _, err := expr.ToSql()
+	require.EqualError(t, err, "range must have at least one bound")
+}
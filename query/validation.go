@@ -1,67 +1,208 @@
 package query
 
 import (
-	"fmt"
+	"errors"
 
 	"github.com/defer-panic/dumbql/schema"
 	"go.uber.org/multierr"
 )
 
 // Validate checks if the binary expression is valid against the schema.
-// If either the left or right expression is invalid, the only valid expression is returned.
-func (b *BinaryExpr) Validate(schema schema.Schema) (Expr, error) {
-	left, err := b.Left.Validate(schema)
-
+// Invalid parts of `and` operands are dropped, and the valid rest of the expression is returned with the error.
+// Operands of `or` are dropped entirely if they have any error, because a partially valid operand can match more
+// than intended, e.g. `(a and invalid) or b` becomes `b`, not `a or b`. Use ValidateStrict to reject the whole
+// query instead.
+func (b *BinaryExpr) Validate(schema schema.Definition) (Expr, error) {
+	left, leftErr := b.Left.Validate(schema)
 	right, rightErr := b.Right.Validate(schema)
-	if rightErr != nil {
-		err = multierr.Append(err, rightErr)
-		if right == nil {
-			return left, err
+	err := multierr.Append(leftErr, rightErr)
+
+	if b.Op == Or {
+		if leftErr != nil {
+			left = nil
+		}
+
+		if rightErr != nil {
+			right = nil
 		}
 	}
 
-	if left == nil {
+	switch {
+	case left == nil:
 		return right, err
+	case right == nil:
+		return left, err
 	}
 
 	return &BinaryExpr{
 		Left:  left,
 		Op:    b.Op,
 		Right: right,
+		Span:  b.Span,
 	}, err
 }
 
-// Validate checks if the not expression is valid against the schema.
-func (n *NotExpr) Validate(schema schema.Schema) (Expr, error) {
+// Validate checks if the not expression is valid against the schema. The whole expression is dropped if the negated
+// expression has any error, because negation of its valid part would match more than intended.
+func (n *NotExpr) Validate(schema schema.Definition) (Expr, error) {
 	expr, err := n.Expr.Validate(schema)
 	if err != nil {
-		if expr == nil {
-			return nil, err
+		return nil, err
+	}
+
+	return &NotExpr{Expr: expr, Span: n.Span}, nil
+}
+
+// ValidateStrict checks the expression against the schema like Expr.Validate, but rejects the whole expression
+// if any part of it is invalid, so nothing is silently dropped from the query.
+func ValidateStrict(expr Expr, schema schema.Definition) (Expr, error) {
+	validated, err := expr.Validate(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	return validated, nil
+}
+
+// Validate expands the term into `~` expressions for every searchable field of the schema. Fields whose rules reject
+// the term are skipped, and the term is dropped if there is no field left to search. Errors point to the term span.
+func (t *TermExpr) Validate(schm schema.Definition) (Expr, error) {
+	var (
+		fields = make([]*FieldExpr, 0)
+		err    error
+	)
+
+	for _, field := range schm.SearchFields() {
+		expr, fieldErr := (&FieldExpr{
+			Field: Identifier(field),
+			Op:    Like,
+			Value: &StringLiteral{StringValue: t.Term, Span: t.Span},
+			Span:  t.Span,
+		}).Validate(schm)
+		if fieldErr != nil {
+			err = multierr.Append(err, fieldErr)
+			continue
 		}
 
-		return expr, err
+		fields = append(fields, expr.(*FieldExpr))
 	}
 
-	return n, nil
+	if len(fields) == 0 {
+		return nil, multierr.Append(err, withSpan(t.Span, &schema.ValidationError{
+			Code:   schema.CodeNoSearchFields,
+			Actual: t.Term,
+		}))
+	}
+
+	return &TermExpr{Term: t.Term, Fields: fields, Span: t.Span}, nil
 }
 
 // Validate checks if the field expression is valid against the corresponding schema rule.
-func (f *FieldExpr) Validate(schm schema.Schema) (Expr, error) {
+// Existence check (field:*) has no value, so only presence of the field in the schema is checked.
+// Both bounds of a range are checked against the rule, and the whole expression is dropped if any of them is invalid.
+// Invalid values of one-of are dropped. It's safe for `!=` (none of) as well, because a value not allowed by
+// the schema can't be equal to any valid one.
+// Operators not allowed for the field by the schema are rejected.
+// Values are converted to the declared type of the field before the rule is checked, values which can't be converted
+// are invalid. Without declared type values are converted to the type expected by the rule if it reports type error.
+// For fields marked as case-insensitive `~` is replaced with `~*` and regular expressions become case-insensitive.
+// Errors are wrapped with SpanError pointing to the field expression, or to the value for one-of values and range
+// bounds.
+func (f *FieldExpr) Validate(schm schema.Definition) (Expr, error) {
 	field := schema.Field(f.Field)
 
-	rule, ok := schm[field]
+	desc, ok := schm.Describe(field)
 	if !ok {
-		return nil, fmt.Errorf("field %q not found in schema", f.Field)
+		return nil, withSpan(f.Span, &schema.ValidationError{Field: field, Code: schema.CodeUnknownField})
+	}
+
+	if op := schema.Operator(f.Op.String()); !desc.Allows(op) {
+		return nil, withSpan(f.Span, &schema.ValidationError{
+			Field:    field,
+			Rule:     "operators",
+			Code:     schema.CodeUnsupportedOperator,
+			Expected: operatorNames(desc.Operators),
+			Actual:   f.Op.String(),
+		})
+	}
+
+	coerced, err := f.coerce(field, desc.Type)
+	if coerced == nil {
+		return nil, err
+	}
+
+	rule := desc.Rule
+	if rule == nil {
+		rule = schema.Any()
+	}
+
+	validated, ruleErr := coerced.validateValue(field, rule)
+	if typ, ok := expectedType(ruleErr); ok && desc.Type == schema.TypeAny {
+		// The type isn't declared, so it's taken from the rule, e.g. float64 for `price:10` and Max[float64] rule.
+		if coerced, err = f.coerce(field, typ); coerced == nil {
+			return nil, err
+		}
+
+		validated, ruleErr = coerced.validateValue(field, rule)
+	}
+
+	err = multierr.Append(err, ruleErr)
+
+	if validated == nil {
+		return nil, err
+	}
+
+	if desc.CaseInsensitive {
+		validated = validated.caseInsensitive()
+	}
+
+	return validated, err
+}
+
+// expectedType returns the type expected by the rule if it has rejected any value with CodeType error.
+func expectedType(err error) (schema.Type, bool) {
+	for _, e := range multierr.Errors(err) {
+		var validationErr *schema.ValidationError
+		if !errors.As(e, &validationErr) || validationErr.Code != schema.CodeType {
+			continue
+		}
+
+		if typ, ok := validationErr.Expected.(schema.Type); ok && typ != schema.TypeAny {
+			return typ, true
+		}
+	}
+
+	return schema.TypeAny, false
+}
+
+func (f *FieldExpr) validateValue(field schema.Field, rule schema.RuleFunc) (*FieldExpr, error) {
+	if f.Op == Exists {
+		return f, nil
+	}
+
+	if r, isRange := f.Value.(*RangeExpr); isRange {
+		return f.validateRange(field, rule, r)
 	}
 
 	oneOf, isOneOf := f.Value.(*OneOfExpr)
 	if !isOneOf {
 		if err := rule(field, f.Value.Value()); err != nil {
-			return nil, err
+			return nil, withSpan(f.Span, err)
 		}
 		return f, nil
 	}
 
+	switch f.Op { //nolint:exhaustive
+	case Equal, NotEqual, Like, ILike:
+	default:
+		return nil, withSpan(f.Span, &schema.ValidationError{
+			Field:    field,
+			Code:     schema.CodeUnsupportedOperator,
+			Expected: []string{Equal.String(), NotEqual.String(), Like.String(), ILike.String()},
+			Actual:   f.Op.String(),
+		})
+	}
+
 	var (
 		values = make([]Valuer, 0, len(oneOf.Values))
 		err    error
@@ -69,7 +210,7 @@ func (f *FieldExpr) Validate(schm schema.Schema) (Expr, error) {
 
 	for _, v := range oneOf.Values {
 		if ruleErr := rule(field, v.Value()); ruleErr != nil {
-			err = multierr.Append(err, ruleErr)
+			err = multierr.Append(err, withSpan(valueSpan(v, f.Span), ruleErr))
 			continue
 		}
 		values = append(values, v)
@@ -78,6 +219,84 @@ func (f *FieldExpr) Validate(schm schema.Schema) (Expr, error) {
 	return &FieldExpr{
 		Field: f.Field,
 		Op:    f.Op,
-		Value: &OneOfExpr{Values: values},
+		Value: &OneOfExpr{Values: values, Span: oneOf.Span},
+		Span:  f.Span,
 	}, err
 }
+
+func operatorNames(ops []schema.Operator) []string {
+	names := make([]string, 0, len(ops))
+	for _, op := range ops {
+		names = append(names, string(op))
+	}
+
+	return names
+}
+
+// caseInsensitive returns copy of the expression with case-insensitive operator, regular expression and strings
+// compared for equality.
+func (f *FieldExpr) caseInsensitive() *FieldExpr {
+	res := *f
+
+	if res.Op == Like {
+		res.Op = ILike
+	}
+
+	switch v := res.Value.(type) {
+	case *RegexLiteral:
+		folded := *v
+		folded.CaseInsensitive = true
+		res.Value = &folded
+	case *StringLiteral:
+		if res.Op == Equal || res.Op == NotEqual {
+			res.Value = foldString(v)
+		}
+	case *OneOfExpr:
+		if res.Op == Equal || res.Op == NotEqual {
+			folded := &OneOfExpr{Values: make([]Valuer, 0, len(v.Values)), Span: v.Span}
+			for _, val := range v.Values {
+				if s, isString := val.(*StringLiteral); isString {
+					val = foldString(s)
+				}
+				folded.Values = append(folded.Values, val)
+			}
+			res.Value = folded
+		}
+	}
+
+	return &res
+}
+
+func foldString(s *StringLiteral) *StringLiteral {
+	folded := *s
+	folded.CaseInsensitive = true
+
+	return &folded
+}
+
+func (f *FieldExpr) validateRange(field schema.Field, rule schema.RuleFunc, r *RangeExpr) (*FieldExpr, error) {
+	var err error
+
+	for _, bound := range []Valuer{r.Low, r.High} {
+		if bound == nil {
+			continue
+		}
+
+		err = multierr.Append(err, withSpan(valueSpan(bound, f.Span), rule(field, bound.Value())))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// valueSpan returns span of the value, or the fallback span if the value has no position, e.g. identifier.
+func valueSpan(v Valuer, fallback Span) Span {
+	if node, ok := v.(interface{ Pos() Span }); ok {
+		return node.Pos()
+	}
+
+	return fallback
+}
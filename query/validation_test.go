@@ -5,10 +5,12 @@ import (
 	"math"
 	"testing"
 
+	"github.com/defer-panic/dumbql/match"
 	"github.com/defer-panic/dumbql/query"
 	"github.com/defer-panic/dumbql/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/multierr"
 )
 
 func TestBinaryExpr_Validate(t *testing.T) { //nolint:funlen
@@ -143,6 +145,40 @@ func TestBinaryExpr_Validate(t *testing.T) { //nolint:funlen
 			require.Nil(t, got)
 		})
 
+		t.Run("or with partially invalid operand", func(t *testing.T) {
+			schm := schema.Schema{
+				"left":  schema.Any(),
+				"right": schema.Any(),
+			}
+
+			// (left:42 and unknown:42) or right:42
+			expr := &query.BinaryExpr{
+				Left: &query.BinaryExpr{
+					Left: &query.FieldExpr{
+						Field: "left",
+						Op:    query.Equal,
+						Value: &query.IntegerLiteral{IntegerValue: 42},
+					},
+					Op: query.And,
+					Right: &query.FieldExpr{
+						Field: "unknown",
+						Op:    query.Equal,
+						Value: &query.IntegerLiteral{IntegerValue: 42},
+					},
+				},
+				Op: query.Or,
+				Right: &query.FieldExpr{
+					Field: "right",
+					Op:    query.Equal,
+					Value: &query.IntegerLiteral{IntegerValue: 42},
+				},
+			}
+
+			got, err := expr.Validate(schm)
+			require.Error(t, err)
+			require.Equal(t, "(= right 42)", got.String())
+		})
+
 		t.Run("unknown field", func(t *testing.T) {
 			schm := schema.Schema{}
 
@@ -214,6 +250,33 @@ func TestNotExpr_Validate(t *testing.T) { //nolint:funlen
 			require.Nil(t, got)
 		})
 
+		t.Run("partially invalid expression", func(t *testing.T) {
+			schm := schema.Schema{
+				"field": schema.Any(),
+			}
+
+			// not (field:42 and unknown:42)
+			expr := &query.NotExpr{
+				Expr: &query.BinaryExpr{
+					Left: &query.FieldExpr{
+						Field: "field",
+						Op:    query.Equal,
+						Value: &query.IntegerLiteral{IntegerValue: 42},
+					},
+					Op: query.And,
+					Right: &query.FieldExpr{
+						Field: "unknown",
+						Op:    query.Equal,
+						Value: &query.IntegerLiteral{IntegerValue: 42},
+					},
+				},
+			}
+
+			got, err := expr.Validate(schm)
+			require.Error(t, err)
+			require.Nil(t, got)
+		})
+
 		t.Run("unknown field", func(t *testing.T) {
 			schm := schema.Schema{}
 
@@ -232,6 +295,59 @@ func TestNotExpr_Validate(t *testing.T) { //nolint:funlen
 	})
 }
 
+func TestValidateStrict(t *testing.T) {
+	schm := schema.Schema{
+		"status": schema.EqualsOneOf("pending", "approved"),
+		"title":  schema.Any(),
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			query: `status:pending and not title:draft`,
+			want:  `(and (= status "pending") (not (= title "draft")))`,
+		},
+		{
+			name:    "invalid and operand",
+			query:   `status:archived and title:draft`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid one of value",
+			query:   `status:[pending, archived]`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			query:   `title:draft or name:John`,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ast, err := query.Parse("test", []byte(test.query))
+			require.NoError(t, err)
+
+			got, err := query.ValidateStrict(ast.(query.Expr), schm)
+			if test.wantErr {
+				require.Error(t, err)
+				require.Nil(t, got)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.want, got.String())
+		})
+	}
+}
+
 func TestFieldExpr_Validate(t *testing.T) { //nolint:funlen
 	t.Run("positive", func(t *testing.T) {
 		t.Run("primitive value", func(t *testing.T) {
@@ -257,6 +373,39 @@ func TestFieldExpr_Validate(t *testing.T) { //nolint:funlen
 			require.Equal(t, int64(42), integerLiteral.IntegerValue)
 		})
 
+		t.Run("exists", func(t *testing.T) {
+			schm := schema.Schema{
+				"field": ruleError,
+			}
+
+			expr := &query.FieldExpr{
+				Field: "field",
+				Op:    query.Exists,
+			}
+
+			got, err := expr.Validate(schm)
+			require.NoError(t, err)
+			require.Equal(t, expr, got)
+		})
+
+		t.Run("range", func(t *testing.T) {
+			schm := schema.Schema{
+				"field": schema.InRange[int64](0, 150),
+			}
+
+			expr := &query.FieldExpr{
+				Field: "field",
+				Op:    query.Equal,
+				Value: &query.RangeExpr{
+					Low: &query.IntegerLiteral{IntegerValue: 18},
+				},
+			}
+
+			got, err := expr.Validate(schm)
+			require.NoError(t, err)
+			require.Equal(t, expr, got)
+		})
+
 		t.Run("one of", func(t *testing.T) {
 			schm := schema.Schema{
 				"field": schema.Any(),
@@ -291,6 +440,83 @@ func TestFieldExpr_Validate(t *testing.T) { //nolint:funlen
 			require.Equal(t, int64(42), integerLiteral.IntegerValue)
 			require.InDelta(t, math.Pi, numberLiteral.NumberValue, 0.01)
 		})
+
+		t.Run("case-insensitive field", func(t *testing.T) {
+			fields := schema.Fields{
+				"email": {Rule: schema.Is[string](), CaseInsensitive: true},
+				"name":  {},
+			}
+
+			tests := []struct {
+				input string
+				want  string
+			}{
+				{input: `email ~ "John"`, want: `(~* email "John")`},
+				{input: `email:/^john@/`, want: `(= email /^john@/i)`},
+				{input: `email:"john@example.com"`, want: `(= email "john@example.com")`},
+				{input: `name ~ "John"`, want: `(~ name "John")`},
+			}
+
+			for _, test := range tests {
+				ast, err := query.Parse("test", []byte(test.input))
+				require.NoError(t, err)
+
+				got, err := ast.(query.Expr).Validate(fields)
+				require.NoError(t, err)
+				assert.Equal(t, test.want, got.String())
+			}
+		})
+	})
+
+	t.Run("operators", func(t *testing.T) {
+		fields := schema.Fields{
+			"id":          {Operators: []schema.Operator{schema.OpEqual}},
+			"description": {Operators: []schema.Operator{schema.OpLike}, CaseInsensitive: true},
+			"title":       {},
+		}
+
+		tests := []struct {
+			input   string
+			want    string
+			wantErr string
+		}{
+			{input: `id:42`, want: `(= id 42)`},
+			{input: `id:[1, 2]`, want: `(= id [1 2])`},
+			{input: `description ~ "broken"`, want: `(~* description "broken")`},
+			{input: `title >= "a"`, want: `(>= title "a")`},
+			{
+				input:   `id > 42`,
+				wantErr: `field "id": operator ">" is not supported, expected one of [=]`,
+			},
+			{
+				input:   `description:*`,
+				wantErr: `field "description": operator "exists" is not supported, expected one of [~]`,
+			},
+			{
+				input:   `description ~* "broken"`,
+				wantErr: `field "description": operator "~*" is not supported, expected one of [~]`,
+			},
+		}
+
+		for _, test := range tests {
+			ast, err := query.Parse("test", []byte(test.input))
+			require.NoError(t, err)
+
+			got, err := ast.(query.Expr).Validate(fields)
+			if test.wantErr != "" {
+				require.EqualError(t, err, test.wantErr)
+				require.Nil(t, got)
+
+				errs := schema.ValidationErrors(err)
+				require.Len(t, errs, 1)
+				assert.Equal(t, schema.CodeUnsupportedOperator, errs[0].Code)
+
+				continue
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got.String())
+		}
 	})
 
 	t.Run("negative", func(t *testing.T) {
@@ -355,9 +581,183 @@ func TestFieldExpr_Validate(t *testing.T) { //nolint:funlen
 			require.Error(t, err)
 			require.Nil(t, got)
 		})
+
+		t.Run("one of unsupported operator", func(t *testing.T) {
+			schm := schema.Schema{
+				"field": schema.Any(),
+			}
+
+			expr := &query.FieldExpr{
+				Field: "field",
+				Op:    query.GreaterThan,
+				Value: &query.OneOfExpr{
+					Values: []query.Valuer{
+						&query.IntegerLiteral{IntegerValue: 42},
+					},
+				},
+			}
+
+			got, err := expr.Validate(schm)
+			require.Error(t, err)
+			require.Nil(t, got)
+		})
+
+		t.Run("range bound rule error", func(t *testing.T) {
+			schm := schema.Schema{
+				"field": schema.Max[int64](50),
+			}
+
+			expr := &query.FieldExpr{
+				Field: "field",
+				Op:    query.Equal,
+				Value: &query.RangeExpr{
+					Low:  &query.IntegerLiteral{IntegerValue: 18},
+					High: &query.IntegerLiteral{IntegerValue: 65},
+				},
+			}
+
+			got, err := expr.Validate(schm)
+			require.Error(t, err)
+			require.Nil(t, got)
+		})
+
+		t.Run("exists unknown field", func(t *testing.T) {
+			schm := schema.Schema{}
+
+			expr := &query.FieldExpr{
+				Field: "field",
+				Op:    query.Exists,
+			}
+
+			got, err := expr.Validate(schm)
+			require.Error(t, err)
+			require.Nil(t, got)
+		})
+	})
+}
+
+func TestTermExpr_Validate(t *testing.T) {
+	fields := schema.Fields{
+		"title": {Rule: schema.LenInRange(1, 5), Searchable: true},
+		"body":  {Searchable: true},
+		"tags":  {},
+	}
+
+	t.Run("all searchable fields", func(t *testing.T) {
+		got, err := (&query.TermExpr{Term: "pipe"}).Validate(fields)
+		require.NoError(t, err)
+
+		term, isTerm := got.(*query.TermExpr)
+		require.True(t, isTerm)
+		require.Len(t, term.Fields, 2)
+		assert.Equal(t, `(~ body "pipe")`, term.Fields[0].String())
+		assert.Equal(t, `(~ title "pipe")`, term.Fields[1].String())
+	})
+
+	t.Run("field rule error", func(t *testing.T) {
+		got, err := (&query.TermExpr{Term: "broken pipe"}).Validate(fields)
+		require.NoError(t, err)
+
+		term, isTerm := got.(*query.TermExpr)
+		require.True(t, isTerm)
+		require.Len(t, term.Fields, 1)
+		assert.Equal(t, `(~ body "broken pipe")`, term.Fields[0].String())
+	})
+
+	t.Run("no searchable fields", func(t *testing.T) {
+		got, err := (&query.TermExpr{Term: "pipe"}).Validate(schema.Schema{"title": schema.Any()})
+		require.Error(t, err)
+		require.Nil(t, got)
 	})
 }
 
+func TestValidate_Spans(t *testing.T) {
+	const input = `status:open and age:[18, 200, 300] and name:John and range:[1 TO 1000]`
+
+	schm := schema.Schema{
+		"status": schema.Any(),
+		"age":    schema.Max[int64](100),
+		"range":  schema.Max[int64](100),
+	}
+
+	ast, err := query.Parse("input", []byte(input))
+	require.NoError(t, err)
+
+	_, err = ast.(query.Expr).Validate(schm)
+	require.Error(t, err)
+
+	var got []string
+
+	for _, e := range multierr.Errors(err) {
+		var spanErr *query.SpanError
+		require.ErrorAs(t, e, &spanErr)
+
+		got = append(got, input[spanErr.Start:spanErr.End])
+	}
+
+	require.Equal(t, []string{"200", "300", "name:John", "1000"}, got)
+
+	var codes []schema.ErrorCode
+	for _, validationErr := range schema.ValidationErrors(err) {
+		codes = append(codes, validationErr.Code)
+	}
+
+	require.Equal(t, []schema.ErrorCode{schema.CodeMax, schema.CodeMax, schema.CodeUnknownField, schema.CodeMax}, codes)
+}
+
+func TestValidate_CaseInsensitiveEquality(t *testing.T) {
+	type User struct {
+		Email string `dumbql:"email"`
+	}
+
+	fields := schema.Fields{"email": {Rule: schema.Is[string](), CaseInsensitive: true}}
+	matcher := &match.StructMatcher{}
+	user := &User{Email: "john@example.com"}
+
+	tests := []struct {
+		input     string
+		wantMatch bool
+		wantSQL   string
+		wantArgs  []any
+	}{
+		{
+			input:     `email:"John@Example.com"`,
+			wantMatch: true,
+			wantSQL:   "LOWER(email) = LOWER(?)",
+			wantArgs:  []any{"John@Example.com"},
+		},
+		{
+			input:     `email != "JOHN@example.com"`,
+			wantMatch: false,
+			wantSQL:   "LOWER(email) <> LOWER(?)",
+			wantArgs:  []any{"JOHN@example.com"},
+		},
+		{
+			input:     `email:["Jane@Example.com", "John@Example.com"]`,
+			wantMatch: true,
+			wantSQL:   "LOWER(email) IN (?,?)",
+			wantArgs:  []any{"jane@example.com", "john@example.com"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			ast, err := query.Parse("test", []byte(test.input))
+			require.NoError(t, err)
+
+			expr, err := ast.(query.Expr).Validate(fields)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.wantMatch, expr.Match(user, matcher))
+
+			sql, args, err := expr.ToSql()
+			require.NoError(t, err)
+			assert.Equal(t, test.wantSQL, sql)
+			assert.Equal(t, test.wantArgs, args)
+		})
+	}
+}
+
 func ruleError(schema.Field, any) error {
 	return errors.New("rule error")
 }
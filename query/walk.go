@@ -0,0 +1,144 @@
+package query
+
+import (
+	"fmt"
+
+	"go.uber.org/multierr"
+)
+
+// Visitor visits nodes of the expression tree. Visit is called for each node encountered by Walk. If the returned
+// visitor w is not nil, Walk visits each of the children of the node with w, followed by a call of w.Visit(nil).
+type Visitor interface {
+	Visit(expr Expr) (w Visitor)
+}
+
+// Walk traverses the expression tree in depth-first order: it starts by calling v.Visit(expr), and then visits
+// children of the node with the returned visitor: operands of BinaryExpr, the negated expression of NotExpr and
+// expanded fields of TermExpr. FieldExpr has no children, its value is not an expression.
+func Walk(v Visitor, expr Expr) {
+	if v = v.Visit(expr); v == nil {
+		return
+	}
+
+	for _, child := range children(expr) {
+		Walk(v, child)
+	}
+
+	v.Visit(nil)
+}
+
+type inspector func(Expr) bool
+
+func (f inspector) Visit(expr Expr) Visitor {
+	if f(expr) {
+		return f
+	}
+
+	return nil
+}
+
+// Inspect traverses the expression tree in depth-first order like Walk. It calls f(expr) for each node, and then
+// visits its children if f returns true. Like in Walk, f(nil) is called after the children.
+func Inspect(expr Expr, f func(Expr) bool) {
+	Walk(inspector(f), expr)
+}
+
+func children(expr Expr) []Expr {
+	switch e := expr.(type) {
+	case *BinaryExpr:
+		return []Expr{e.Left, e.Right}
+	case *NotExpr:
+		return []Expr{e.Expr}
+	case *TermExpr:
+		res := make([]Expr, 0, len(e.Fields))
+		for _, f := range e.Fields {
+			res = append(res, f)
+		}
+
+		return res
+	default:
+		return nil
+	}
+}
+
+// Rewrite transforms the expression tree in post-order: children of the node are rewritten first, and then f is called
+// with copy of the node holding the rewritten children. The original tree is not modified.
+//
+// If f returns nil, the node is dropped: operand of BinaryExpr is replaced with the other operand, NotExpr without
+// expression is dropped as well, and the field is removed from TermExpr. Fields of TermExpr must be rewritten into
+// *FieldExpr. Errors of all nodes are combined, and nil expression is returned if there is any.
+func Rewrite(expr Expr, f func(Expr) (Expr, error)) (Expr, error) {
+	res, err := rewrite(expr, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func rewrite(expr Expr, f func(Expr) (Expr, error)) (Expr, error) {
+	switch e := expr.(type) {
+	case *BinaryExpr:
+		left, leftErr := rewrite(e.Left, f)
+		right, rightErr := rewrite(e.Right, f)
+
+		if err := multierr.Append(leftErr, rightErr); err != nil {
+			return nil, err
+		}
+
+		// Remaining operand is already rewritten.
+		switch {
+		case left == nil:
+			return right, nil
+		case right == nil:
+			return left, nil
+		}
+
+		return f(&BinaryExpr{Left: left, Op: e.Op, Right: right, Span: e.Span})
+	case *NotExpr:
+		inner, err := rewrite(e.Expr, f)
+		if err != nil || inner == nil {
+			return nil, err
+		}
+
+		return f(&NotExpr{Expr: inner, Span: e.Span})
+	case *TermExpr:
+		return rewriteTerm(e, f)
+	case *FieldExpr:
+		res := *e
+		return f(&res)
+	default:
+		return f(expr)
+	}
+}
+
+func rewriteTerm(t *TermExpr, f func(Expr) (Expr, error)) (Expr, error) {
+	var (
+		fields = make([]*FieldExpr, 0, len(t.Fields))
+		err    error
+	)
+
+	for _, field := range t.Fields {
+		rewritten, rewriteErr := rewrite(field, f)
+		if rewriteErr != nil {
+			err = multierr.Append(err, rewriteErr)
+			continue
+		}
+
+		switch r := rewritten.(type) {
+		case nil:
+		case *FieldExpr:
+			if r != nil {
+				fields = append(fields, r)
+			}
+		default:
+			err = multierr.Append(err, fmt.Errorf("term %q: field rewritten into %T", t.Term, rewritten))
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return f(&TermExpr{Term: t.Term, Fields: fields, Span: t.Span})
+}
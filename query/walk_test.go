@@ -0,0 +1,185 @@
+package query_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/defer-panic/dumbql/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingVisitor struct {
+	visited *[]string
+}
+
+func (v recordingVisitor) Visit(expr query.Expr) query.Visitor {
+	if expr == nil {
+		*v.visited = append(*v.visited, "end")
+		return nil
+	}
+
+	*v.visited = append(*v.visited, expr.String())
+
+	return v
+}
+
+func TestWalk(t *testing.T) {
+	ast, err := query.Parse("test", []byte(`status:200 and not eps<0.003`))
+	require.NoError(t, err)
+
+	var visited []string
+	query.Walk(recordingVisitor{visited: &visited}, ast.(query.Expr))
+
+	assert.Equal(t, []string{
+		"(and (= status 200) (not (< eps 0.003000)))",
+		"(= status 200)",
+		"end",
+		"(not (< eps 0.003000))",
+		"(< eps 0.003000)",
+		"end",
+		"end",
+		"end",
+	}, visited)
+}
+
+func TestInspect(t *testing.T) {
+	ast, err := query.Parse("test", []byte(`a:1 or (b:2 and not c:3)`))
+	require.NoError(t, err)
+
+	var fields []string
+
+	query.Inspect(ast.(query.Expr), func(expr query.Expr) bool {
+		switch e := expr.(type) {
+		case *query.FieldExpr:
+			fields = append(fields, e.Field.String())
+		case *query.NotExpr:
+			// Skip negated fields.
+			return false
+		}
+
+		return true
+	})
+
+	assert.Equal(t, []string{"a", "b"}, fields)
+}
+
+func TestRewrite(t *testing.T) { //nolint:funlen
+	tests := []struct {
+		name    string
+		input   string
+		rewrite func(query.Expr) (query.Expr, error)
+		want    string
+	}{
+		{
+			name:  "rename fields",
+			input: `a:1 and not b:2`,
+			rewrite: func(expr query.Expr) (query.Expr, error) {
+				if f, ok := expr.(*query.FieldExpr); ok {
+					f.Field = query.Identifier("t." + f.Field.String())
+				}
+				return expr, nil
+			},
+			want: "(and (= t.a 1) (not (= t.b 2)))",
+		},
+		{
+			name:  "drop field",
+			input: `a:1 and (b:2 or c:3)`,
+			rewrite: func(expr query.Expr) (query.Expr, error) {
+				if f, ok := expr.(*query.FieldExpr); ok && f.Field == "b" {
+					return nil, nil
+				}
+				return expr, nil
+			},
+			want: "(and (= a 1) (= c 3))",
+		},
+		{
+			name:  "drop negated field",
+			input: `a:1 and not b:2`,
+			rewrite: func(expr query.Expr) (query.Expr, error) {
+				if f, ok := expr.(*query.FieldExpr); ok && f.Field == "b" {
+					return nil, nil
+				}
+				return expr, nil
+			},
+			want: "(= a 1)",
+		},
+		{
+			name:  "post-order",
+			input: `not (not a:1)`,
+			rewrite: func(expr query.Expr) (query.Expr, error) {
+				// Children are already rewritten, so double negation is removed from the bottom.
+				if n, ok := expr.(*query.NotExpr); ok {
+					if inner, ok := n.Expr.(*query.NotExpr); ok {
+						return inner.Expr, nil
+					}
+				}
+				return expr, nil
+			},
+			want: "(= a 1)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ast, err := query.Parse("test", []byte(test.input))
+			require.NoError(t, err)
+
+			expr := ast.(query.Expr)
+			original := expr.String()
+
+			got, err := query.Rewrite(expr, test.rewrite)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got.String())
+			assert.Equal(t, original, expr.String(), "original expression must not be modified")
+		})
+	}
+}
+
+func TestRewrite_Errors(t *testing.T) {
+	ast, err := query.Parse("test", []byte(`a:1 and b:2 and c:3`))
+	require.NoError(t, err)
+
+	got, err := query.Rewrite(ast.(query.Expr), func(expr query.Expr) (query.Expr, error) {
+		if f, ok := expr.(*query.FieldExpr); ok && f.Field != "b" {
+			return nil, fmt.Errorf("field %s", f.Field)
+		}
+		return expr, nil
+	})
+
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.EqualError(t, err, "field a; field c")
+}
+
+func TestRewrite_Term(t *testing.T) {
+	expr := &query.TermExpr{
+		Term: "john",
+		Fields: []*query.FieldExpr{
+			{Field: "name", Op: query.Like, Value: &query.StringLiteral{StringValue: "john"}},
+			{Field: "email", Op: query.Like, Value: &query.StringLiteral{StringValue: "john"}},
+		},
+	}
+
+	got, err := query.Rewrite(expr, func(expr query.Expr) (query.Expr, error) {
+		if f, ok := expr.(*query.FieldExpr); ok && f.Field == "email" {
+			return nil, nil
+		}
+		return expr, nil
+	})
+	require.NoError(t, err)
+
+	term, ok := got.(*query.TermExpr)
+	require.True(t, ok)
+	require.Len(t, term.Fields, 1)
+	assert.Equal(t, query.Identifier("name"), term.Fields[0].Field)
+	assert.Len(t, expr.Fields, 2)
+
+	_, err = query.Rewrite(expr, func(expr query.Expr) (query.Expr, error) {
+		if _, ok := expr.(*query.FieldExpr); ok {
+			return &query.NotExpr{Expr: expr}, nil
+		}
+		return expr, nil
+	})
+	assert.ErrorContains(t, err, `term "john": field rewritten into *query.NotExpr`)
+}
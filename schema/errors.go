@@ -0,0 +1,141 @@
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.uber.org/multierr"
+)
+
+// ErrorCode identifies the kind of validation failure. Codes are stable, so they can be used for localization.
+type ErrorCode string
+
+const (
+	CodeInvalid             ErrorCode = "invalid"              // Error of a custom rule, see ValidationError.Err.
+	CodeUnknownField        ErrorCode = "unknown_field"        // Field is not defined in the schema.
+	CodeUnsupportedOperator ErrorCode = "unsupported_operator" // Operator can't be used with the field or value.
+	CodeNoSearchFields      ErrorCode = "no_search_fields"     // There are no fields to search a free text term in.
+	CodeNull                ErrorCode = "null"                 // Value must not be null.
+	CodeType                ErrorCode = "type"                 // Value has unexpected type.
+	CodeRange               ErrorCode = "range"                // Value is out of range.
+	CodeMin                 ErrorCode = "min"                  // Value is less than minimum.
+	CodeMax                 ErrorCode = "max"                  // Value is greater than maximum.
+	CodeAfter               ErrorCode = "after"                // Point in time is not after the expected one.
+	CodeBefore              ErrorCode = "before"               // Point in time is not before the expected one.
+	CodeLength              ErrorCode = "length"               // Length is out of range.
+	CodeMinLength           ErrorCode = "min_length"           // Length is less than minimum.
+	CodeMaxLength           ErrorCode = "max_length"           // Length is greater than maximum.
+	CodeOneOf               ErrorCode = "one_of"               // Value is not one of allowed values.
+)
+
+// ValidationError describes a value rejected by the schema. Expected and Actual depend on the code, e.g. for CodeType
+// the expected value is Type and the actual one is the name of the value type, and for CodeRange the expected value
+// is Bounds.
+type ValidationError struct {
+	Field    Field     `json:"field,omitempty"`
+	Rule     string    `json:"rule,omitempty"` // Name of the rule, e.g. "max", empty for errors of query structure.
+	Code     ErrorCode `json:"code"`
+	Expected any       `json:"expected,omitempty"`
+	Actual   any       `json:"actual,omitempty"`
+	Err      error     `json:"-"` // Original error for CodeInvalid.
+}
+
+func (e *ValidationError) Error() string { //nolint:cyclop
+	switch e.Code {
+	case CodeUnknownField:
+		return fmt.Sprintf("field %q not found in schema", e.Field)
+	case CodeUnsupportedOperator:
+		if e.Expected != nil {
+			return fmt.Sprintf("field %q: operator %q is not supported, expected one of %v", e.Field, e.Actual, e.Expected)
+		}
+		return fmt.Sprintf("field %q: operator %q is not supported", e.Field, e.Actual)
+	case CodeNoSearchFields:
+		return fmt.Sprintf("term %q: no fields to search", e.Actual)
+	case CodeNull:
+		return fmt.Sprintf("field %q: value must not be null", e.Field)
+	case CodeType:
+		return fmt.Sprintf("field %q: value must be %v, got %v", e.Field, e.Expected, e.Actual)
+	case CodeRange:
+		return fmt.Sprintf("field %q: value must be in range %v, got %v", e.Field, e.Expected, e.Actual)
+	case CodeMin:
+		return fmt.Sprintf("field %q: value must be equal or greater than %v, got %v", e.Field, e.Expected, e.Actual)
+	case CodeMax:
+		return fmt.Sprintf("field %q: value must be equal or less than %v, got %v", e.Field, e.Expected, e.Actual)
+	case CodeAfter:
+		return fmt.Sprintf("field %q: value must be after %v, got %v", e.Field, e.Expected, e.Actual)
+	case CodeBefore:
+		return fmt.Sprintf("field %q: value must be before %v, got %v", e.Field, e.Expected, e.Actual)
+	case CodeLength:
+		return fmt.Sprintf("field %q: len must be in range %v, got %v", e.Field, e.Expected, e.Actual)
+	case CodeMinLength:
+		return fmt.Sprintf("field %q: len must be equal or greater than %v, got %v", e.Field, e.Expected, e.Actual)
+	case CodeMaxLength:
+		return fmt.Sprintf("field %q: len must be equal or less than %v, got %v", e.Field, e.Expected, e.Actual)
+	case CodeOneOf:
+		return fmt.Sprintf("field %q: value must be one of %v, got %v", e.Field, e.Expected, e.Actual)
+	default:
+		if e.Err != nil {
+			return e.Err.Error()
+		}
+		return fmt.Sprintf("field %q: invalid value %v", e.Field, e.Actual)
+	}
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON adds human-readable message to the JSON representation.
+func (e ValidationError) MarshalJSON() ([]byte, error) { //nolint:gocritic
+	type validationError ValidationError
+
+	return json.Marshal(struct {
+		validationError
+		Message string `json:"message"`
+	}{
+		validationError: validationError(e),
+		Message:         e.Error(),
+	})
+}
+
+// Bounds is the expected range of a value or length.
+type Bounds struct {
+	Min any `json:"min"`
+	Max any `json:"max"`
+}
+
+func (b Bounds) String() string {
+	return fmt.Sprintf("[%v, %v]", b.Min, b.Max)
+}
+
+// ValidationErrors extracts validation errors from err, which may combine several errors, e.g. the one returned by
+// query validation. Errors of other types are converted to ValidationError with CodeInvalid.
+func ValidationErrors(err error) []ValidationError {
+	errs := multierr.Errors(err)
+	res := make([]ValidationError, 0, len(errs))
+
+	for _, e := range errs {
+		var validationErr *ValidationError
+		if errors.As(e, &validationErr) {
+			res = append(res, *validationErr)
+			continue
+		}
+
+		res = append(res, ValidationError{Code: CodeInvalid, Err: e})
+	}
+
+	return res
+}
+
+// typeError reports that the typed rule got a value of another type. Expected is the Type of the sample value, so
+// query validation can convert the value to it.
+func typeError(field Field, rule string, expected, actual any) error {
+	return &ValidationError{
+		Field:    field,
+		Rule:     rule,
+		Code:     CodeType,
+		Expected: typeOf(expected),
+		Actual:   fmt.Sprintf("%T", actual),
+	}
+}
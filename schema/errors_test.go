@@ -0,0 +1,189 @@
+package schema_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/defer-panic/dumbql/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/multierr"
+)
+
+func TestRules_ValidationError(t *testing.T) { //nolint:funlen
+	threshold := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		rule    schema.RuleFunc
+		value   any
+		want    schema.ValidationError
+		wantMsg string
+	}{
+		{
+			name:    "not null",
+			rule:    schema.NotNull(),
+			value:   nil,
+			want:    schema.ValidationError{Field: "field", Rule: "not_null", Code: schema.CodeNull},
+			wantMsg: `field "field": value must not be null`,
+		},
+		{
+			name:  "in range",
+			rule:  schema.InRange[int64](1, 10),
+			value: int64(42),
+			want: schema.ValidationError{
+				Field:    "field",
+				Rule:     "in_range",
+				Code:     schema.CodeRange,
+				Expected: schema.Bounds{Min: int64(1), Max: int64(10)},
+				Actual:   int64(42),
+			},
+			wantMsg: `field "field": value must be in range [1, 10], got 42`,
+		},
+		{
+			name:  "min type mismatch",
+			rule:  schema.Min[int64](1),
+			value: "42",
+			want: schema.ValidationError{
+				Field:    "field",
+				Rule:     "min",
+				Code:     schema.CodeType,
+				Expected: schema.TypeInt64,
+				Actual:   "string",
+			},
+			wantMsg: `field "field": value must be int64, got string`,
+		},
+		{
+			name:  "max",
+			rule:  schema.Max(3.5),
+			value: 4.5,
+			want: schema.ValidationError{
+				Field:    "field",
+				Rule:     "max",
+				Code:     schema.CodeMax,
+				Expected: 3.5,
+				Actual:   4.5,
+			},
+			wantMsg: `field "field": value must be equal or less than 3.5, got 4.5`,
+		},
+		{
+			name:  "before",
+			rule:  schema.Before(threshold),
+			value: threshold,
+			want: schema.ValidationError{
+				Field:    "field",
+				Rule:     "before",
+				Code:     schema.CodeBefore,
+				Expected: threshold,
+				Actual:   threshold,
+			},
+		},
+		{
+			name:  "max len",
+			rule:  schema.MaxLen(3),
+			value: "hello",
+			want: schema.ValidationError{
+				Field:    "field",
+				Rule:     "max_len",
+				Code:     schema.CodeMaxLength,
+				Expected: 3,
+				Actual:   5,
+			},
+			wantMsg: `field "field": len must be equal or less than 3, got 5`,
+		},
+		{
+			name:  "is",
+			rule:  schema.Is[bool](),
+			value: nil,
+			want: schema.ValidationError{
+				Field:    "field",
+				Rule:     "is",
+				Code:     schema.CodeType,
+				Expected: schema.TypeBool,
+				Actual:   "<nil>",
+			},
+		},
+		{
+			name:  "equals one of",
+			rule:  schema.EqualsOneOf("open", "closed"),
+			value: "pending",
+			want: schema.ValidationError{
+				Field:    "field",
+				Rule:     "equals_one_of",
+				Code:     schema.CodeOneOf,
+				Expected: []any{"open", "closed"},
+				Actual:   "pending",
+			},
+			wantMsg: `field "field": value must be one of [open closed], got pending`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.rule("field", test.value)
+
+			var validationErr *schema.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, test.want, *validationErr)
+
+			if test.wantMsg != "" {
+				assert.EqualError(t, err, test.wantMsg)
+			}
+		})
+	}
+}
+
+func TestValidationErrors(t *testing.T) {
+	custom := errors.New("custom rule error")
+
+	err := multierr.Combine(
+		schema.Max[int64](3)("period_months", int64(4)),
+		fmt.Errorf("wrapped: %w", schema.NotNull()("deleted_at", nil)),
+		custom,
+	)
+
+	got := schema.ValidationErrors(err)
+	require.Len(t, got, 3)
+
+	assert.Equal(t, schema.CodeMax, got[0].Code)
+	assert.Equal(t, schema.Field("period_months"), got[0].Field)
+	assert.Equal(t, schema.CodeNull, got[1].Code)
+	assert.Equal(t, schema.Field("deleted_at"), got[1].Field)
+	assert.Equal(t, schema.CodeInvalid, got[2].Code)
+	assert.ErrorIs(t, &got[2], custom)
+
+	assert.Empty(t, schema.ValidationErrors(nil))
+}
+
+func TestValidationError_MarshalJSON(t *testing.T) {
+	err := schema.InRange[int64](1, 10)("age", int64(42))
+
+	data, marshalErr := json.Marshal(schema.ValidationErrors(err))
+	require.NoError(t, marshalErr)
+
+	assert.JSONEq(t, `[{
+		"field": "age",
+		"rule": "in_range",
+		"code": "range",
+		"expected": {"min": 1, "max": 10},
+		"actual": 42,
+		"message": "field \"age\": value must be in range [1, 10], got 42"
+	}]`, string(data))
+
+	err = schema.Is[int64]()("age", "42")
+
+	data, marshalErr = json.Marshal(schema.ValidationErrors(err))
+	require.NoError(t, marshalErr)
+
+	assert.JSONEq(t, `[{
+		"field": "age",
+		"rule": "is",
+		"code": "type",
+		"expected": "int64",
+		"actual": "string",
+		"message": "field \"age\": value must be int64, got string"
+	}]`, string(data))
+}
@@ -1,6 +1,6 @@
 package schema
 
-import "fmt"
+import "time"
 
 func Any(rules ...RuleFunc) RuleFunc {
 	return func(field Field, value any) error {
@@ -25,15 +25,35 @@ func All(rules ...RuleFunc) RuleFunc {
 	}
 }
 
+// Nullable allows null value for the field. Non-null values are checked with the rule.
+func Nullable(rule RuleFunc) RuleFunc {
+	return func(field Field, value any) error {
+		if value == nil {
+			return nil
+		}
+		return rule(field, value)
+	}
+}
+
+// NotNull forbids null value for the field.
+func NotNull() RuleFunc {
+	return func(field Field, value any) error {
+		if value == nil {
+			return &ValidationError{Field: field, Rule: "not_null", Code: CodeNull}
+		}
+		return nil
+	}
+}
+
 func InRange[T Numeric](min, max T) RuleFunc { //nolint:revive
 	return func(field Field, value any) error {
 		if v, ok := value.(T); ok {
 			if v < min || v > max {
-				return fmt.Errorf("field %q: value must be in range [%v, %v], got %v", field, min, max, v)
+				return &ValidationError{Field: field, Rule: "in_range", Code: CodeRange, Expected: Bounds{min, max}, Actual: v}
 			}
 			return nil
 		}
-		return fmt.Errorf("field %q: value must be %T, got %T", field, min, value)
+		return typeError(field, "in_range", min, value)
 	}
 }
 
@@ -41,11 +61,11 @@ func Min[T Numeric](min T) RuleFunc { //nolint:revive
 	return func(field Field, value any) error {
 		if v, ok := value.(T); ok {
 			if v < min {
-				return fmt.Errorf("field %q: value must be equal or greater than %v, got %v", field, min, v)
+				return &ValidationError{Field: field, Rule: "min", Code: CodeMin, Expected: min, Actual: v}
 			}
 			return nil
 		}
-		return fmt.Errorf("field %q: value must be %T, got %T", field, min, value)
+		return typeError(field, "min", min, value)
 	}
 }
 
@@ -53,11 +73,37 @@ func Max[T Numeric](max T) RuleFunc { //nolint:revive
 	return func(field Field, value any) error {
 		if v, ok := value.(T); ok {
 			if v > max {
-				return fmt.Errorf("field %q: value must be equal or less than %v, got %v", field, max, v)
+				return &ValidationError{Field: field, Rule: "max", Code: CodeMax, Expected: max, Actual: v}
+			}
+			return nil
+		}
+		return typeError(field, "max", max, value)
+	}
+}
+
+// After checks that the value is a point in time after t.
+func After(t time.Time) RuleFunc {
+	return func(field Field, value any) error {
+		if v, ok := value.(time.Time); ok {
+			if !v.After(t) {
+				return &ValidationError{Field: field, Rule: "after", Code: CodeAfter, Expected: t, Actual: v}
+			}
+			return nil
+		}
+		return typeError(field, "after", t, value)
+	}
+}
+
+// Before checks that the value is a point in time before t.
+func Before(t time.Time) RuleFunc {
+	return func(field Field, value any) error {
+		if v, ok := value.(time.Time); ok {
+			if !v.Before(t) {
+				return &ValidationError{Field: field, Rule: "before", Code: CodeBefore, Expected: t, Actual: v}
 			}
 			return nil
 		}
-		return fmt.Errorf("field %q: value must be %T, got %T", field, max, value)
+		return typeError(field, "before", t, value)
 	}
 }
 
@@ -65,11 +111,17 @@ func LenInRange(min, max int) RuleFunc { //nolint:revive
 	return func(field Field, value any) error {
 		if v, ok := value.(string); ok {
 			if len(v) < min || len(v) > max {
-				return fmt.Errorf("field %q: len must be in range [%d, %d], got %d", field, min, max, len(v))
+				return &ValidationError{
+					Field:    field,
+					Rule:     "len_in_range",
+					Code:     CodeLength,
+					Expected: Bounds{min, max},
+					Actual:   len(v),
+				}
 			}
 			return nil
 		}
-		return fmt.Errorf("field %q: value must be string, got %v", field, value)
+		return typeError(field, "len_in_range", "", value)
 	}
 }
 
@@ -77,11 +129,11 @@ func MinLen(min int) RuleFunc { //nolint:revive
 	return func(field Field, value any) error {
 		if v, ok := value.(string); ok {
 			if len(v) < min {
-				return fmt.Errorf("field %q: len must be greater than %d, got %d", field, min, len(v))
+				return &ValidationError{Field: field, Rule: "min_len", Code: CodeMinLength, Expected: min, Actual: len(v)}
 			}
 			return nil
 		}
-		return fmt.Errorf("field %q: value must be string, got %v", field, value)
+		return typeError(field, "min_len", "", value)
 	}
 }
 
@@ -89,18 +141,18 @@ func MaxLen(max int) RuleFunc { //nolint:revive
 	return func(field Field, value any) error {
 		if v, ok := value.(string); ok {
 			if len(v) > max {
-				return fmt.Errorf("field %q: value must be less than %d, got %d", field, max, len(v))
+				return &ValidationError{Field: field, Rule: "max_len", Code: CodeMaxLength, Expected: max, Actual: len(v)}
 			}
 			return nil
 		}
-		return fmt.Errorf("field %q: value must be string, got %v", field, value)
+		return typeError(field, "max_len", "", value)
 	}
 }
 
 func Is[T ValueType]() RuleFunc {
 	return func(field Field, value any) error {
 		if v, ok := value.(T); !ok {
-			return fmt.Errorf("field %q: value must be %T, got %T", field, v, value)
+			return typeError(field, "is", v, value)
 		}
 		return nil
 	}
@@ -113,6 +165,6 @@ func EqualsOneOf(values ...any) RuleFunc {
 				return nil
 			}
 		}
-		return fmt.Errorf("field %q: value must be one of %v, got %v", field, values, value)
+		return &ValidationError{Field: field, Rule: "equals_one_of", Code: CodeOneOf, Expected: values, Actual: value}
 	}
 }
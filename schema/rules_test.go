@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/defer-panic/dumbql/schema"
 	"github.com/stretchr/testify/assert"
@@ -35,6 +36,31 @@ func TestAll(t *testing.T) {
 	})
 }
 
+func TestNullable(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		rule := schema.Nullable(schema.Is[string]())
+		require.NoError(t, rule("positive_null", nil))
+		require.NoError(t, rule("positive_string", "Hello, world!"))
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		rule := schema.Nullable(schema.Is[string]())
+		require.Error(t, rule("negative", int64(42)))
+	})
+}
+
+func TestNotNull(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		rule := schema.NotNull()
+		require.NoError(t, rule("positive", "Hello, world!"))
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		rule := schema.NotNull()
+		require.Error(t, rule("negative", nil))
+	})
+}
+
 func TestInRange(t *testing.T) {
 	t.Run("int64", func(t *testing.T) {
 		t.Run("positive", func(t *testing.T) {
@@ -101,7 +127,7 @@ func TestMin(t *testing.T) {
 	})
 }
 
-func TestMax(t *testing.T) {
+func TestMax(t *testing.T) { //nolint:funlen
 	t.Run("int64", func(t *testing.T) {
 		t.Run("positive", func(t *testing.T) {
 			rule := schema.Max[int64](42)
@@ -125,6 +151,60 @@ func TestMax(t *testing.T) {
 			require.Error(t, rule("negative", 42.43))
 		})
 	})
+
+	t.Run("duration", func(t *testing.T) {
+		t.Run("positive", func(t *testing.T) {
+			rule := schema.Max(time.Hour)
+			require.NoError(t, rule("positive", 30*time.Minute))
+		})
+
+		t.Run("negative", func(t *testing.T) {
+			rule := schema.Max(time.Hour)
+			require.Error(t, rule("negative", 2*time.Hour))
+		})
+	})
+}
+
+func TestAfter(t *testing.T) {
+	threshold := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("positive", func(t *testing.T) {
+		rule := schema.After(threshold)
+		require.NoError(t, rule("positive", threshold.Add(time.Second)))
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("wrong value", func(t *testing.T) {
+			rule := schema.After(threshold)
+			require.Error(t, rule("negative", threshold))
+		})
+
+		t.Run("wrong type", func(t *testing.T) {
+			rule := schema.After(threshold)
+			require.Error(t, rule("negative", "2024-01-02"))
+		})
+	})
+}
+
+func TestBefore(t *testing.T) {
+	threshold := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("positive", func(t *testing.T) {
+		rule := schema.Before(threshold)
+		require.NoError(t, rule("positive", threshold.Add(-time.Second)))
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("wrong value", func(t *testing.T) {
+			rule := schema.Before(threshold)
+			require.Error(t, rule("negative", threshold))
+		})
+
+		t.Run("wrong type", func(t *testing.T) {
+			rule := schema.Before(threshold)
+			require.Error(t, rule("negative", int64(42)))
+		})
+	})
 }
 
 func TestLenInRange(t *testing.T) {
@@ -220,6 +300,30 @@ func TestIs(t *testing.T) {
 			require.Error(t, rule("float64_negative", "Hello, world!"))
 		})
 	})
+
+	t.Run("bool", func(t *testing.T) {
+		t.Run("positive", func(t *testing.T) {
+			rule := schema.Is[bool]()
+			require.NoError(t, rule("bool_positive", true))
+		})
+
+		t.Run("negative", func(t *testing.T) {
+			rule := schema.Is[bool]()
+			require.Error(t, rule("bool_negative", "true"))
+		})
+	})
+
+	t.Run("time", func(t *testing.T) {
+		t.Run("positive", func(t *testing.T) {
+			rule := schema.Is[time.Time]()
+			require.NoError(t, rule("time_positive", time.Now()))
+		})
+
+		t.Run("negative", func(t *testing.T) {
+			rule := schema.Is[time.Time]()
+			require.Error(t, rule("time_negative", "2024-01-01"))
+		})
+	})
 }
 
 func TestEqualsOneOf(t *testing.T) {
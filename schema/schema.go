@@ -1,17 +1,167 @@
 package schema
 
+import (
+	"slices"
+	"time"
+)
+
 type Field string
 
 // RuleFunc defines a function type for validating a field value and returning an error if validation fails.
 type RuleFunc func(field Field, value any) error
 
+// Definition describes fields which can be used in the query.
+type Definition interface {
+	// Describe returns descriptor of the field and reports whether the field is defined.
+	Describe(field Field) (Descriptor, bool)
+	// SearchFields returns fields which are searched by free text terms.
+	SearchFields() []Field
+}
+
+// Descriptor holds the validation rule and matching options of the field.
+type Descriptor struct {
+	// Rule validates the field value. Nil rule accepts any value.
+	Rule RuleFunc
+	// CaseInsensitive forces case-insensitive matching: `~` becomes `~*`, regular expressions get the `i` flag, and
+	// strings compared with `=`, `!=` and one-of ignore case.
+	CaseInsensitive bool
+	// Searchable includes the field into free text search, so terms without field name are matched against it.
+	Searchable bool
+	// Text marks the field as analyzed full text in search engines, e.g. `text` field in Elasticsearch, as opposed to
+	// keyword fields which are matched exactly.
+	Text bool
+	// Type is the declared type of the field value. Query literals are converted to it during validation, before
+	// the rule is called, e.g. `price:10` gives float64 value for TypeFloat64 field. For zero type the type is taken
+	// from the rule: if it rejects the value with CodeType error, the value is converted to the Type set as expected
+	// value of the error and checked again, so `price:10` is valid for Max[float64] rule as well.
+	Type Type
+	// JSONColumn is the name of the JSON column holding the field in SQL database, e.g. "data". Dialect returned by
+	// query.PostgreSQLJSONB translates the field into path in the column. Empty name means a regular column.
+	JSONColumn string
+	// Operators lists operators allowed for the field. Nil list allows any operator. One-of expressions are checked
+	// by their operator, e.g. `id:[1, 2]` is allowed with OpEqual.
+	Operators []Operator
+}
+
+// Allows reports whether the operator can be used with the field.
+func (d Descriptor) Allows(op Operator) bool {
+	return d.Operators == nil || slices.Contains(d.Operators, op)
+}
+
+// Operator is a field operator of the query as it's written in the query, e.g. "=" or "~".
+type Operator string
+
+const (
+	OpEqual              Operator = "="
+	OpNotEqual           Operator = "!="
+	OpGreaterThan        Operator = ">"
+	OpGreaterThanOrEqual Operator = ">="
+	OpLessThan           Operator = "<"
+	OpLessThanOrEqual    Operator = "<="
+	OpLike               Operator = "~"
+	OpILike              Operator = "~*"
+	OpExists             Operator = "exists"
+)
+
 // Schema is a set of Field to RuleFunc pairs which defines constraints for the query validation.
 type Schema map[Field]RuleFunc
 
+func (s Schema) Describe(field Field) (Descriptor, bool) {
+	rule, ok := s[field]
+	return Descriptor{Rule: rule}, ok
+}
+
+// SearchFields returns nil, because Schema has no searchable fields. Use Fields to define them.
+func (s Schema) SearchFields() []Field {
+	return nil
+}
+
+// Fields is a set of Field to Descriptor pairs. Unlike Schema it allows to set matching options per field.
+type Fields map[Field]Descriptor
+
+func (f Fields) Describe(field Field) (Descriptor, bool) {
+	desc, ok := f[field]
+	return desc, ok
+}
+
+// SearchFields returns searchable fields sorted by name.
+func (f Fields) SearchFields() []Field {
+	var fields []Field
+
+	for field, desc := range f {
+		if desc.Searchable {
+			fields = append(fields, field)
+		}
+	}
+
+	slices.Sort(fields)
+
+	return fields
+}
+
+// Type is the declared type of the field value.
+type Type uint8
+
+const (
+	TypeAny Type = iota
+	TypeString
+	TypeInt64
+	TypeFloat64
+	TypeBool
+	TypeTime
+	TypeDuration
+)
+
+func (t Type) String() string {
+	switch t {
+	case TypeAny:
+		return "any"
+	case TypeString:
+		return "string"
+	case TypeInt64:
+		return "int64"
+	case TypeFloat64:
+		return "float64"
+	case TypeBool:
+		return "bool"
+	case TypeTime:
+		return "time.Time"
+	case TypeDuration:
+		return "time.Duration"
+	default:
+		return "unknown!"
+	}
+}
+
+// MarshalText encodes the type as its name, e.g. in JSON of ValidationError.
+func (t Type) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// typeOf returns the type of the value, or TypeAny if it's not one of the value types.
+func typeOf(v any) Type {
+	switch v.(type) {
+	case string:
+		return TypeString
+	case int64:
+		return TypeInt64
+	case float64:
+		return TypeFloat64
+	case bool:
+		return TypeBool
+	case time.Time:
+		return TypeTime
+	case time.Duration:
+		return TypeDuration
+	default:
+		return TypeAny
+	}
+}
+
 type ValueType interface {
-	string | Numeric
+	string | bool | Numeric | time.Time
 }
 
 type Numeric interface {
-	float64 | int64
+	float64 | int64 | time.Duration
 }
@@ -0,0 +1,266 @@
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+)
+
+// FromStruct derives fields from the struct type T. Field names are taken from the `dumbql` tag, like in
+// match.StructMatcher, or from the Go field name if the tag is not set. Fields marked with dumbql:"-" and unexported
+// fields are skipped. Fields of nested structs are named with dots, e.g. `profile.age`.
+//
+// Go field types are mapped to the Is rule and the declared Type: strings, booleans, integers (as int64), floats
+// (as float64), time.Time and time.Duration. Pointer fields are nullable. Fields of other types are skipped.
+// Recursive types, e.g. a struct with a pointer to itself, are rejected.
+//
+// Tag options after the name add constraints:
+//
+//	min=<n>, max=<n>          value bounds for numbers and durations
+//	min_len=<n>, max_len=<n>  length bounds for strings
+//	one_of=<a>|<b>            allowed values
+//	ops=eq|ne|gt|gte|lt|lte|like|ilike|exists
+//	                          allowed operators
+//	searchable                search free text terms in the field
+//	case_insensitive          match the field case-insensitively
+//	text                      analyzed full text field in search engines
+//
+// For example:
+//
+//	type User struct {
+//		Name string `dumbql:"name,max_len=100,searchable"`
+//		Age  int    `dumbql:"age,min=0,max=150,ops=eq|gt|lt"`
+//	}
+func FromStruct[T any]() (Fields, error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("schema from struct: %s is not a struct", t)
+	}
+
+	fields := make(Fields)
+	if err := describeStruct(fields, t, "", false, map[reflect.Type]bool{t: true}); err != nil {
+		return nil, fmt.Errorf("schema from struct %s: %w", t, err)
+	}
+
+	return fields, nil
+}
+
+// describeStruct adds fields of the struct to the schema. Fields of structs referenced by pointers are nullable.
+// Path holds the structs being described, a struct nested into itself would give infinite number of fields.
+func describeStruct(fields Fields, t reflect.Type, prefix string, nullable bool, path map[reflect.Type]bool) error {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		tag := f.Tag.Get("dumbql")
+		if tag == "-" {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = f.Name
+		}
+		name = prefix + name
+
+		ft, isNullable := f.Type, nullable
+		if ft.Kind() == reflect.Ptr {
+			ft, isNullable = ft.Elem(), true
+		}
+
+		if ft.Kind() == reflect.Struct && ft != timeType {
+			if path[ft] {
+				return fmt.Errorf("field %q: recursive type %s", name, ft)
+			}
+
+			path[ft] = true
+			err := describeStruct(fields, ft, name+".", isNullable, path)
+			delete(path, ft)
+
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		typ, rule := fieldType(ft)
+		if typ == TypeAny {
+			continue
+		}
+
+		desc, err := parseTagOptions(typ, rule, options)
+		if err != nil {
+			return fmt.Errorf("field %q: %w", name, err)
+		}
+
+		if isNullable {
+			desc.Rule = Nullable(desc.Rule)
+		}
+
+		fields[Field(name)] = desc
+	}
+
+	return nil
+}
+
+// fieldType returns declared type and type rule for the Go type, or TypeAny if the type is not supported.
+func fieldType(t reflect.Type) (Type, RuleFunc) {
+	switch t {
+	case timeType:
+		return TypeTime, Is[time.Time]()
+	case durationType:
+		return TypeDuration, Is[time.Duration]()
+	}
+
+	switch t.Kind() { //nolint:exhaustive
+	case reflect.String:
+		return TypeString, Is[string]()
+	case reflect.Bool:
+		return TypeBool, Is[bool]()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return TypeInt64, Is[int64]()
+	case reflect.Float32, reflect.Float64:
+		return TypeFloat64, Is[float64]()
+	default:
+		return TypeAny, nil
+	}
+}
+
+var tagOperators = map[string]Operator{
+	"eq":     OpEqual,
+	"ne":     OpNotEqual,
+	"gt":     OpGreaterThan,
+	"gte":    OpGreaterThanOrEqual,
+	"lt":     OpLessThan,
+	"lte":    OpLessThanOrEqual,
+	"like":   OpLike,
+	"ilike":  OpILike,
+	"exists": OpExists,
+}
+
+func parseTagOptions(typ Type, rule RuleFunc, options string) (Descriptor, error) {
+	desc := Descriptor{Type: typ}
+	rules := []RuleFunc{rule}
+
+	for _, opt := range strings.Split(options, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(opt), "=")
+
+		switch key {
+		case "":
+		case "searchable":
+			desc.Searchable = true
+		case "case_insensitive":
+			desc.CaseInsensitive = true
+		case "text":
+			desc.Text = true
+		case "ops":
+			for _, name := range strings.Split(value, "|") {
+				op, ok := tagOperators[name]
+				if !ok {
+					return Descriptor{}, fmt.Errorf("unknown operator %q", name)
+				}
+				desc.Operators = append(desc.Operators, op)
+			}
+		default:
+			r, err := tagRule(typ, key, value)
+			if err != nil {
+				return Descriptor{}, err
+			}
+			rules = append(rules, r)
+		}
+	}
+
+	desc.Rule = rules[0]
+	if len(rules) > 1 {
+		desc.Rule = All(rules...)
+	}
+
+	return desc, nil
+}
+
+// tagRule returns the rule for the constraint option.
+func tagRule(typ Type, key, value string) (RuleFunc, error) {
+	switch key {
+	case "min", "max":
+		return boundRule(typ, key, value)
+	case "min_len", "max_len":
+		if typ != TypeString {
+			return nil, fmt.Errorf("option %q is not supported for %s", key, typ)
+		}
+
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("option %q: %w", key, err)
+		}
+
+		if key == "min_len" {
+			return MinLen(n), nil
+		}
+		return MaxLen(n), nil
+	case "one_of":
+		var values []any
+		for _, s := range strings.Split(value, "|") {
+			v, err := parseTagValue(typ, s)
+			if err != nil {
+				return nil, fmt.Errorf("option %q: %w", key, err)
+			}
+			values = append(values, v)
+		}
+		return EqualsOneOf(values...), nil
+	default:
+		return nil, fmt.Errorf("unknown option %q", key)
+	}
+}
+
+func boundRule(typ Type, key, value string) (RuleFunc, error) {
+	v, err := parseTagValue(typ, value)
+	if err != nil {
+		return nil, fmt.Errorf("option %q: %w", key, err)
+	}
+
+	switch v := v.(type) {
+	case int64:
+		return bound(key, v), nil
+	case float64:
+		return bound(key, v), nil
+	case time.Duration:
+		return bound(key, v), nil
+	default:
+		return nil, fmt.Errorf("option %q is not supported for %s", key, typ)
+	}
+}
+
+func bound[T Numeric](key string, v T) RuleFunc {
+	if key == "min" {
+		return Min(v)
+	}
+	return Max(v)
+}
+
+// parseTagValue parses the option value as the declared type.
+func parseTagValue(typ Type, s string) (any, error) {
+	switch typ { //nolint:exhaustive
+	case TypeInt64:
+		return strconv.ParseInt(s, 10, 64)
+	case TypeFloat64:
+		return strconv.ParseFloat(s, 64)
+	case TypeDuration:
+		return time.ParseDuration(s)
+	case TypeBool:
+		return strconv.ParseBool(s)
+	case TypeString:
+		return s, nil
+	default:
+		return nil, fmt.Errorf("values of %s are not supported", typ)
+	}
+}
@@ -0,0 +1,143 @@
+package schema_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/defer-panic/dumbql/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type address struct {
+	City string `dumbql:"city,one_of=Barcelona|Madrid"`
+}
+
+type user struct {
+	Name      string         `dumbql:"name,max_len=5,searchable,case_insensitive,text"`
+	Age       int            `dumbql:"age,min=0,max=150,ops=eq|gt|lt"`
+	Score     float32        `dumbql:"score"`
+	Active    bool           `dumbql:"active"`
+	CreatedAt time.Time      `dumbql:"created_at"`
+	Timeout   time.Duration  `dumbql:"timeout,max=1h"`
+	DeletedAt *time.Time     `dumbql:"deleted_at"`
+	Address   address        `dumbql:"address"`
+	Manager   *address       `dumbql:"manager"`
+	Password  string         `dumbql:"-"`
+	Tags      []string       `dumbql:"tags"`
+	Extra     map[string]any `dumbql:"extra"`
+	Internal  int64
+	private   string
+}
+
+func TestFromStruct(t *testing.T) { //nolint:funlen
+	fields, err := schema.FromStruct[user]()
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []schema.Field{
+		"name", "age", "score", "active", "created_at", "timeout", "deleted_at", "address.city", "manager.city",
+		"Internal",
+	}, keys(fields))
+
+	assert.Equal(t, []schema.Field{"name"}, fields.SearchFields())
+
+	tests := []struct {
+		field   schema.Field
+		typ     schema.Type
+		valid   []any
+		invalid []any
+	}{
+		{field: "name", typ: schema.TypeString, valid: []any{"John"}, invalid: []any{"Johnny", int64(1), nil}},
+		{field: "age", typ: schema.TypeInt64, valid: []any{int64(0), int64(150)}, invalid: []any{int64(-1), int64(151), 1.5}},
+		{field: "score", typ: schema.TypeFloat64, valid: []any{4.5}, invalid: []any{int64(4)}},
+		{field: "active", typ: schema.TypeBool, valid: []any{true}, invalid: []any{"true"}},
+		{field: "created_at", typ: schema.TypeTime, valid: []any{time.Now()}, invalid: []any{nil}},
+		{field: "timeout", typ: schema.TypeDuration, valid: []any{time.Minute}, invalid: []any{2 * time.Hour}},
+		{field: "deleted_at", typ: schema.TypeTime, valid: []any{nil, time.Now()}, invalid: []any{"now"}},
+		{field: "address.city", typ: schema.TypeString, valid: []any{"Madrid"}, invalid: []any{"Paris"}},
+		{field: "manager.city", typ: schema.TypeString, valid: []any{"Barcelona", nil}, invalid: []any{"Paris"}},
+		{field: "Internal", typ: schema.TypeInt64, valid: []any{int64(1)}, invalid: []any{"1"}},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.field), func(t *testing.T) {
+			desc, ok := fields.Describe(test.field)
+			require.True(t, ok)
+			assert.Equal(t, test.typ, desc.Type)
+
+			for _, v := range test.valid {
+				assert.NoError(t, desc.Rule(test.field, v), v)
+			}
+
+			for _, v := range test.invalid {
+				assert.Error(t, desc.Rule(test.field, v), v)
+			}
+		})
+	}
+
+	name, _ := fields.Describe("name")
+	assert.True(t, name.CaseInsensitive)
+	assert.True(t, name.Text)
+
+	age, _ := fields.Describe("age")
+	assert.Equal(t, []schema.Operator{schema.OpEqual, schema.OpGreaterThan, schema.OpLessThan}, age.Operators)
+	assert.True(t, age.Allows(schema.OpEqual))
+	assert.False(t, age.Allows(schema.OpLike))
+}
+
+func TestFromStruct_Error(t *testing.T) {
+	type (
+		unknownOption struct {
+			Name string `dumbql:"name,unknown"`
+		}
+		unknownOperator struct {
+			Name string `dumbql:"name,ops=eq|in"`
+		}
+		invalidBound struct {
+			Age int `dumbql:"age,min=zero"`
+		}
+		unsupportedBound struct {
+			Name string `dumbql:"name,max=10"`
+		}
+		node struct {
+			Name string `dumbql:"name"`
+			Next *node  `dumbql:"next"`
+		}
+		tree struct {
+			Root struct {
+				Children []tree `dumbql:"children"`
+				Parent   *tree  `dumbql:"parent"`
+			} `dumbql:"root"`
+		}
+	)
+
+	_, err := schema.FromStruct[unknownOption]()
+	require.ErrorContains(t, err, `field "name": unknown option "unknown"`)
+
+	_, err = schema.FromStruct[unknownOperator]()
+	require.ErrorContains(t, err, `field "name": unknown operator "in"`)
+
+	_, err = schema.FromStruct[invalidBound]()
+	require.ErrorContains(t, err, `field "age": option "min"`)
+
+	_, err = schema.FromStruct[unsupportedBound]()
+	require.ErrorContains(t, err, `field "name": option "max" is not supported for string`)
+
+	_, err = schema.FromStruct[node]()
+	require.ErrorContains(t, err, `field "next": recursive type schema_test.node`)
+
+	_, err = schema.FromStruct[tree]()
+	require.ErrorContains(t, err, `field "root.parent": recursive type schema_test.tree`)
+
+	_, err = schema.FromStruct[int]()
+	require.EqualError(t, err, "schema from struct: int is not a struct")
+}
+
+func keys(fields schema.Fields) []schema.Field {
+	res := make([]schema.Field, 0, len(fields))
+	for field := range fields {
+		res = append(res, field)
+	}
+
+	return res
+}